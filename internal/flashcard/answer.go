@@ -0,0 +1,153 @@
+package flashcard
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// similarAnswer is the similarity from which a typed answer with a few mistakes is suggested as hard.
+const similarAnswer = 0.8
+
+// DiffKind tells how a piece of the typed answer compares to the expected one.
+type DiffKind int
+
+const (
+	// DiffEqual is typed as expected.
+	DiffEqual DiffKind = iota
+	// DiffMissing is expected but was not typed.
+	DiffMissing
+	// DiffExtra is typed but was not expected.
+	DiffExtra
+)
+
+// Diff is a piece of the comparison between the typed and the expected answers.
+type Diff struct {
+	Kind DiffKind
+	Text string
+}
+
+// Comparison is the character level difference between the typed and the expected answers.
+type Comparison struct {
+	Diffs []Diff
+	// Similarity goes from zero, nothing in common, to one, the same answers.
+	Similarity float64
+	// Score is the suggested score for the typed answer.
+	Score ReviewScore
+}
+
+// Correct reports whether the typed answer is the expected one.
+func (c Comparison) Correct() bool {
+	return c.Similarity == 1
+}
+
+// Expected returns the answer to type for the card, the hidden text for the cloze cards.
+func (c Card) Expected() string {
+	if !c.IsCloze() {
+		return c.Answer
+	}
+
+	var texts []string
+	for _, match := range clozePattern.FindAllStringSubmatch(c.Question, -1) {
+		if match[1] == strconv.Itoa(c.Cloze) {
+			texts = append(texts, match[2])
+		}
+	}
+	return strings.Join(texts, ", ")
+}
+
+// SetTypeAnswer sets whether the card, and the other cards of its note, ask to type the answer.
+func (d Deck) SetTypeAnswer(card Card, typeAnswer bool) (Deck, Card) {
+	for _, c := range d.Siblings(card) {
+		c.TypeAnswer = typeAnswer
+		d = d.Change(c)
+	}
+	card.TypeAnswer = typeAnswer
+	return d, card
+}
+
+// CompareAnswer compares the typed answer to the expected one, after normalizing both as set in the settings,
+// and suggests good for the correct answers, hard for the ones with a few mistakes and again for the others.
+func CompareAnswer(typed, expected string, settings Settings) Comparison {
+	a, b := []rune(normalize(typed, settings)), []rune(normalize(expected, settings))
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diffs []Diff
+	add := func(kind DiffKind, r rune) {
+		if n := len(diffs); n > 0 && diffs[n-1].Kind == kind {
+			diffs[n-1].Text += string(r)
+			return
+		}
+		diffs = append(diffs, Diff{Kind: kind, Text: string(r)})
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			add(DiffEqual, a[i])
+			i, j = i+1, j+1
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			add(DiffExtra, a[i])
+			i++
+		default:
+			add(DiffMissing, b[j])
+			j++
+		}
+	}
+
+	similarity := 1.0
+	if total := len(a) + len(b); total > 0 {
+		similarity = float64(2*lcs[0][0]) / float64(total)
+	}
+
+	score := ReviewScoreAgain
+	switch {
+	case len(a) == 0:
+	case similarity == 1:
+		score = ReviewScoreGood
+	case similarity >= similarAnswer:
+		score = ReviewScoreHard
+	}
+
+	return Comparison{Diffs: diffs, Similarity: similarity, Score: score}
+}
+
+// normalize prepares an answer to be compared, ignoring what the settings ask to.
+func normalize(answer string, settings Settings) string {
+	if settings.IgnoreCase {
+		answer = strings.ToLower(answer)
+	}
+
+	if settings.IgnoreWhitespace {
+		answer = strings.Join(strings.Fields(answer), " ")
+	}
+
+	if settings.IgnoreAccents {
+		var b strings.Builder
+		for _, r := range norm.NFD.String(answer) {
+			if !unicode.Is(unicode.Mn, r) {
+				b.WriteRune(r)
+			}
+		}
+		answer = norm.NFC.String(b.String())
+	}
+
+	return answer
+}
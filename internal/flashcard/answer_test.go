@@ -0,0 +1,140 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestCompareAnswer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		typed    string
+		expected string
+		settings flashcard.Settings
+		diffs    []flashcard.Diff
+		score    flashcard.ReviewScore
+	}{
+		{
+			name:     "correct",
+			typed:    "git rebase",
+			expected: "git rebase",
+			diffs:    []flashcard.Diff{{Kind: flashcard.DiffEqual, Text: "git rebase"}},
+			score:    flashcard.ReviewScoreGood,
+		},
+		{
+			name:     "few mistakes",
+			typed:    "git rebsae",
+			expected: "git rebase",
+			diffs: []flashcard.Diff{
+				{Kind: flashcard.DiffEqual, Text: "git reb"},
+				{Kind: flashcard.DiffExtra, Text: "s"},
+				{Kind: flashcard.DiffEqual, Text: "a"},
+				{Kind: flashcard.DiffMissing, Text: "s"},
+				{Kind: flashcard.DiffEqual, Text: "e"},
+			},
+			score: flashcard.ReviewScoreHard,
+		},
+		{
+			name:     "wrong",
+			typed:    "pull",
+			expected: "rebase",
+			diffs: []flashcard.Diff{
+				{Kind: flashcard.DiffExtra, Text: "pull"},
+				{Kind: flashcard.DiffMissing, Text: "rebase"},
+			},
+			score: flashcard.ReviewScoreAgain,
+		},
+		{
+			name:     "empty",
+			typed:    "",
+			expected: "rebase",
+			diffs:    []flashcard.Diff{{Kind: flashcard.DiffMissing, Text: "rebase"}},
+			score:    flashcard.ReviewScoreAgain,
+		},
+		{
+			name:     "case sensitive",
+			typed:    "Rebase",
+			expected: "rebase",
+			diffs: []flashcard.Diff{
+				{Kind: flashcard.DiffExtra, Text: "R"},
+				{Kind: flashcard.DiffMissing, Text: "r"},
+				{Kind: flashcard.DiffEqual, Text: "ebase"},
+			},
+			score: flashcard.ReviewScoreHard,
+		},
+		{
+			name:     "ignore case",
+			typed:    "Rebase",
+			expected: "rebase",
+			settings: flashcard.Settings{IgnoreCase: true},
+			diffs:    []flashcard.Diff{{Kind: flashcard.DiffEqual, Text: "rebase"}},
+			score:    flashcard.ReviewScoreGood,
+		},
+		{
+			name:     "ignore whitespace",
+			typed:    " git   rebase ",
+			expected: "git rebase",
+			settings: flashcard.Settings{IgnoreWhitespace: true},
+			diffs:    []flashcard.Diff{{Kind: flashcard.DiffEqual, Text: "git rebase"}},
+			score:    flashcard.ReviewScoreGood,
+		},
+		{
+			name:     "ignore accents",
+			typed:    "cafe",
+			expected: "café",
+			settings: flashcard.Settings{IgnoreAccents: true},
+			diffs:    []flashcard.Diff{{Kind: flashcard.DiffEqual, Text: "cafe"}},
+			score:    flashcard.ReviewScoreGood,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				comparison := flashcard.CompareAnswer(tt.typed, tt.expected, tt.settings)
+
+				assert.Equal(t, tt.diffs, comparison.Diffs)
+				assert.Equal(t, tt.score, comparison.Score)
+				assert.Equal(t, tt.score == flashcard.ReviewScoreGood, comparison.Correct())
+			},
+		)
+	}
+}
+
+func TestCard_Expected(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("answer", testclock.New(now), nil)
+	require.NoError(t, err)
+
+	_, cards, err := deck.AddCloze("{{c1::git}} {{c2::rebase::verb}} {{c1::main}}", "extra")
+	require.NoError(t, err)
+
+	assert.Equal(t, "git, main", cards[0].Expected())
+	assert.Equal(t, "rebase", cards[1].Expected())
+	assert.Equal(t, "answer", flashcard.NewCard("question", "answer", now).Expected())
+}
+
+func TestDeck_SetTypeAnswer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("answer", testclock.New(now), []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+	require.NoError(t, err)
+	deck, card, reverse := deck.AddReversible("dog", "cachorro")
+
+	deck, card = deck.SetTypeAnswer(card, true)
+
+	assert.True(t, card.TypeAnswer)
+	assert.True(t, getCard(deck, card.ID).TypeAnswer)
+	assert.True(t, getCard(deck, reverse.ID).TypeAnswer)
+	assert.False(t, getCard(deck, deck.Cards[0].ID).TypeAnswer)
+}
@@ -0,0 +1,162 @@
+package flashcard
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/avelino/slugify"
+)
+
+const (
+	// archiveDeck is the name of the deck file in the archive.
+	archiveDeck = "deck.json"
+	// archiveMedia is the directory of the media files in the archive.
+	archiveMedia = "media/"
+	// maxMediaSize is the size in bytes of the largest media file extracted from an archive.
+	maxMediaSize = 32 << 20
+)
+
+// ErrInvalidArchive is returned when the archive has no deck file or the deck is invalid.
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// Export writes the deck and the media files referenced by its cards to a zip archive.
+// The media files missing from the media directory are left out.
+func (r *Repository) Export(deck Deck, w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	file, err := archive.Create(archiveDeck)
+	if err != nil {
+		return fmt.Errorf("export deck '%s': %w", deck.ID, err)
+	}
+	if err := json.NewEncoder(file).Encode(&deck); err != nil {
+		return fmt.Errorf("export deck '%s': %w", deck.ID, err)
+	}
+
+	dir := mediaDirpath(r.path, deck.ID)
+	for _, name := range deck.Media() {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("export media '%s': %w", name, err)
+		}
+
+		file, err := archive.Create(archiveMedia + name)
+		if err != nil {
+			return fmt.Errorf("export media '%s': %w", name, err)
+		}
+		if _, err := file.Write(data); err != nil {
+			return fmt.Errorf("export media '%s': %w", name, err)
+		}
+	}
+
+	return archive.Close()
+}
+
+// Import creates a deck from a zip archive written by Export, along with its media files.
+func (r *Repository) Import(reader io.ReaderAt, size int64) (Deck, error) {
+	archive, err := zip.NewReader(reader, size)
+	if err != nil {
+		return Deck{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	deck, err := readArchiveDeck(archive)
+	if err != nil {
+		return Deck{}, err
+	}
+
+	deck.ID = slugify.Slugify(deck.Name)
+	deck.clock = r.clock
+	deck.mediaDir = mediaDirpath(r.path, deck.ID)
+	if _, ok := r.decks[deck.ID]; ok {
+		return Deck{}, fmt.Errorf("deck '%s' already exists", deck.Name)
+	}
+
+	if err := r.validator.Struct(deck); err != nil {
+		return Deck{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	// The media is extracted to a temporary directory moved in place once the deck is saved,
+	// so a failed import leaves no files behind.
+	tmp, err := os.MkdirTemp(r.path, deck.ID+MediaSuffix+"-")
+	if err != nil {
+		return Deck{}, fmt.Errorf("import media: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	for _, file := range archive.File {
+		name, ok := strings.CutPrefix(file.Name, archiveMedia)
+		if !ok || strings.HasSuffix(name, "/") {
+			continue
+		}
+		if err := extractMedia(file, tmp, name); err != nil {
+			return Deck{}, err
+		}
+	}
+
+	if err := r.Save(deck); err != nil {
+		return Deck{}, err
+	}
+
+	if err := os.Rename(tmp, deck.mediaDir); err != nil {
+		return Deck{}, errors.Join(fmt.Errorf("import media: %w", err), r.Delete(deck))
+	}
+
+	return deck, nil
+}
+
+func readArchiveDeck(archive *zip.Reader) (Deck, error) {
+	file, err := archive.Open(archiveDeck)
+	if err != nil {
+		return Deck{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer file.Close()
+
+	deck := Deck{Settings: DefaultSettings()}
+	if err := json.NewDecoder(file).Decode(&deck); err != nil {
+		return Deck{}, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if deck.Name == "" {
+		return Deck{}, fmt.Errorf("%w: missing name", ErrInvalidArchive)
+	}
+
+	return deck, nil
+}
+
+func extractMedia(file *zip.File, dir, name string) error {
+	name, ok := mediaName(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidMedia, file.Name)
+	}
+
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o777); err != nil {
+		return fmt.Errorf("import media '%s': %w", name, err)
+	}
+
+	source, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("import media '%s': %w", name, err)
+	}
+	defer source.Close()
+
+	data, err := io.ReadAll(io.LimitReader(source, maxMediaSize+1))
+	if err != nil {
+		return fmt.Errorf("import media '%s': %w", name, err)
+	}
+	if len(data) > maxMediaSize {
+		return fmt.Errorf("%w: media '%s' is larger than %d bytes", ErrInvalidArchive, name, maxMediaSize)
+	}
+
+	if err := os.WriteFile(target, data, 0o644); err != nil {
+		return fmt.Errorf("import media '%s': %w", name, err)
+	}
+	return nil
+}
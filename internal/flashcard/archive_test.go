@@ -0,0 +1,99 @@
+package flashcard_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eliostvs/lembrol/internal/clock"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+	"github.com/eliostvs/lembrol/internal/test"
+)
+
+func TestRepository_Export(t *testing.T) {
+	t.Parallel()
+
+	export := func(t *testing.T) *bytes.Reader {
+		t.Helper()
+
+		repo := newTestRepository(t, test.TempCopyDir(t, mediaDeckPath), clock.New())
+		deck, err := repo.Find("Diagrams")
+		require.NoError(t, err)
+
+		var archive bytes.Buffer
+		require.NoError(t, repo.Export(deck, &archive))
+		return bytes.NewReader(archive.Bytes())
+	}
+
+	t.Run(
+		"imports the deck along with the media referenced", func(t *testing.T) {
+			archive := export(t)
+			repo := newTestRepository(t, t.TempDir(), clock.New())
+
+			deck, err := repo.Import(archive, archive.Size())
+
+			require.NoError(t, err)
+			assert.Equal(t, "Diagrams", deck.Name)
+			assert.Len(t, deck.Cards, 2)
+			assert.FileExists(t, filepath.Join(deck.MediaDir(), "handshake.png"))
+			assert.NoFileExists(t, filepath.Join(deck.MediaDir(), "orphan.png"))
+
+			saved, err := repo.Find("Diagrams")
+			require.NoError(t, err)
+			assert.Equal(t, deck.Cards, saved.Cards)
+		},
+	)
+
+	t.Run(
+		"returns error when the deck already exists", func(t *testing.T) {
+			archive := export(t)
+			repo := newTestRepository(t, test.TempCopyDir(t, mediaDeckPath), clock.New())
+
+			_, err := repo.Import(archive, archive.Size())
+
+			assert.ErrorContains(t, err, "deck 'Diagrams' already exists")
+		},
+	)
+
+	t.Run(
+		"returns error when the archive is invalid", func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(mediaDeckPath, "diagrams.json"))
+			require.NoError(t, err)
+			repo := newTestRepository(t, t.TempDir(), clock.New())
+
+			_, err = repo.Import(bytes.NewReader(data), int64(len(data)))
+
+			assert.ErrorIs(t, err, flashcard.ErrInvalidArchive)
+		},
+	)
+
+	t.Run(
+		"leaves no media behind when the deck is invalid", func(t *testing.T) {
+			var data bytes.Buffer
+			archive := zip.NewWriter(&data)
+			file, err := archive.Create("deck.json")
+			require.NoError(t, err)
+			_, err = file.Write([]byte(`{"name": "Broken", "cards": [], "settings": {"day_starts_at": 25}}`))
+			require.NoError(t, err)
+			file, err = archive.Create("media/handshake.png")
+			require.NoError(t, err)
+			_, err = file.Write([]byte("png"))
+			require.NoError(t, err)
+			require.NoError(t, archive.Close())
+			dir := t.TempDir()
+			repo := newTestRepository(t, dir, clock.New())
+
+			_, err = repo.Import(bytes.NewReader(data.Bytes()), int64(data.Len()))
+
+			assert.ErrorIs(t, err, flashcard.ErrInvalidArchive)
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, entries)
+		},
+	)
+}
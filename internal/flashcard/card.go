@@ -34,7 +34,7 @@ func NewCard(question, answer string, today time.Time) Card {
 type Card struct {
 	ID       string  `json:"id" validate:"required"`
 	Question string  `json:"question" validate:"required"`
-	Answer   string  `json:"answer" validate:"required"`
+	Answer   string  `json:"answer" validate:"required_without=Cloze"`
 	Stats    []Stats `json:"stats"`
 	// FSRS-specific fields
 	Due           time.Time  `json:"due"`
@@ -46,6 +46,39 @@ type Card struct {
 	Lapses        uint64     `json:"lapses"`
 	State         fsrs.State `json:"state"`
 	LastReview    time.Time  `json:"last_review,omitzero" validate:"required"`
+	// Step is the learning or relearning step the card is in.
+	Step int `json:"step,omitempty"`
+	// Suspended cards are left out of the reviews until they are unsuspended.
+	Suspended bool `json:"suspended,omitempty"`
+	// BuriedUntil is the instant when a buried card returns to the reviews.
+	BuriedUntil time.Time `json:"buried_until,omitzero"`
+	// Leech cards are the ones that keep being forgotten.
+	Leech bool     `json:"leech,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	// Note is the ID shared by the cards generated from the same cloze text, or by a card and its reverse.
+	Note string `json:"note,omitempty"`
+	// Reversed is set on the card generated with the question and answer of another card swapped.
+	Reversed bool `json:"reversed,omitempty"`
+	// TypeAnswer cards ask to type the answer in the review, which is compared to the expected one.
+	TypeAnswer bool `json:"type_answer,omitempty"`
+	// MultipleChoice cards ask to choose the answer among the distractors.
+	MultipleChoice bool `json:"multiple_choice,omitempty"`
+	// Distractors are the wrong options of a multiple choice card, drawn from the deck when empty.
+	Distractors []string `json:"distractors,omitempty"`
+	// Hints are revealed one at a time in the review, before the answer.
+	Hints []string `json:"hints,omitempty"`
+	// Flag marks the card with a colour, to come back to it later.
+	Flag Flag `json:"flag,omitempty" validate:"omitempty,oneof=red orange green blue purple"`
+	// Notes are personal notes about the card, shown with the answer.
+	Notes string `json:"notes,omitempty"`
+	// Type is the name of the note type that rendered the card, empty for the Basic cards.
+	Type string `json:"type,omitempty"`
+	// Fields are the values of the note the card was rendered from.
+	Fields map[string]string `json:"fields,omitempty"`
+	// Template is the index of the note type template that rendered the card.
+	Template int `json:"template,omitempty" validate:"gte=0"`
+	// Cloze is the index of the cloze deletion hidden by the card, zero for the other cards.
+	Cloze int `json:"cloze,omitempty" validate:"gte=0"`
 }
 
 func (c Card) AddStats(s Stats) Card {
@@ -53,11 +86,64 @@ func (c Card) AddStats(s Stats) Card {
 	return c
 }
 
+// IsBuried reports whether the card is buried at the instant t.
+func (c Card) IsBuried(t time.Time) bool {
+	return t.Before(c.BuriedUntil)
+}
+
 // IsDue reports whether the card is due at the instant t.
-func (c Card) IsDue(t time.Time) bool {
-	if !c.Due.IsZero() {
-		return !c.Due.After(t)
+// Learning cards are due at the exact instant they were scheduled,
+// the others are due during the whole study day they were scheduled to.
+func (c Card) IsDue(t time.Time, settings Settings) bool {
+	due := c.Due
+	if due.IsZero() {
+		due = c.LastReview
+	}
+
+	if c.State == fsrs.Learning || c.State == fsrs.Relearning {
+		return !due.After(t)
+	}
+
+	return !settings.StudyDay(due).After(settings.StudyDay(t))
+}
+
+// SetDue schedules the card to the given instant.
+func (c Card) SetDue(due time.Time) Card {
+	c.Due = due
+	c.ScheduledDays = 0
+	if due.After(c.LastReview) {
+		c.ScheduledDays = uint64(due.Sub(c.LastReview) / (24 * time.Hour))
+	}
+	return c
+}
+
+// Forget resets the card to the new state as if it was never reviewed.
+// The review history is kept only when keepStats is true.
+func (c Card) Forget(now time.Time, keepStats bool) Card {
+	fsrsCard := fsrs.NewCard()
+
+	c.Due = now
+	c.Stability = fsrsCard.Stability
+	c.Difficulty = fsrsCard.Difficulty
+	c.ElapsedDays = fsrsCard.ElapsedDays
+	c.ScheduledDays = fsrsCard.ScheduledDays
+	c.Reps = fsrsCard.Reps
+	c.Lapses = fsrsCard.Lapses
+	c.State = fsrsCard.State
+	c.LastReview = now
+	c.Step = 0
+	c.Leech = false
+	if !keepStats {
+		c.Stats = nil
 	}
 
-	return c.LastReview.Before(t) || c.LastReview.Equal(t)
+	return c
+}
+
+// rewrite replaces the question and answer of the card.
+// A rewritten card deserves a new chance before being called a leech again.
+func rewrite(c Card, question, answer string) Card {
+	c.Question, c.Answer = question, answer
+	c.Leech = false
+	return c
 }
@@ -13,40 +13,83 @@ import (
 func TestCard_IsDue(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
+	// 21:00 in UTC, 18:00 in São Paulo
+	now := time.Date(2021, 1, 10, 21, 0, 0, 0, time.UTC)
 	tomorrow := now.Add(24 * time.Hour)
 	yesterday := now.Add(-24 * time.Hour)
+	defaults := flashcard.DefaultSettings()
 
 	tests := []struct {
-		name string
-		card flashcard.Card
-		want bool
+		name     string
+		card     flashcard.Card
+		settings flashcard.Settings
+		want     bool
 	}{
 		{
-			name: "returns true when card is due now",
-			card: flashcard.Card{Due: now},
-			want: true,
+			name:     "returns true when card is due now",
+			card:     flashcard.Card{Due: now},
+			settings: defaults,
+			want:     true,
+		},
+		{
+			name:     "returns true when card was due yesterday",
+			card:     flashcard.Card{Due: yesterday},
+			settings: defaults,
+			want:     true,
+		},
+		{
+			name:     "returns false when card is due tomorrow",
+			card:     flashcard.Card{Due: tomorrow},
+			settings: defaults,
+			want:     false,
+		},
+		{
+			name:     "returns true for new cards with zero due date",
+			card:     flashcard.Card{LastReview: now},
+			settings: defaults,
+			want:     true,
 		},
 		{
-			name: "returns true when card was due yesterday",
-			card: flashcard.Card{Due: yesterday},
-			want: true,
+			name:     "returns true when card is due later in the same study day",
+			card:     flashcard.Card{Due: now.Add(5 * time.Hour), State: fsrs.Review},
+			settings: defaults,
+			want:     true,
 		},
 		{
-			name: "returns false when card is due tomorrow",
-			card: flashcard.Card{Due: tomorrow},
-			want: false,
+			name:     "returns false when card is due after the next day starts",
+			card:     flashcard.Card{Due: now.Add(8 * time.Hour), State: fsrs.Review},
+			settings: defaults,
+			want:     false,
 		},
 		{
-			name: "returns true for new cards with zero due date",
-			card: flashcard.Card{LastReview: now},
-			want: true,
+			name:     "returns true when the next day starts later",
+			card:     flashcard.Card{Due: now.Add(8 * time.Hour), State: fsrs.Review},
+			settings: flashcard.Settings{DayStartsAt: 6},
+			want:     true,
+		},
+		{
+			name:     "returns false when learning card is due later today",
+			card:     flashcard.Card{Due: now.Add(time.Hour), State: fsrs.Learning},
+			settings: defaults,
+			want:     false,
+		},
+		{
+			name:     "returns true when learning card was due earlier",
+			card:     flashcard.Card{Due: now.Add(-time.Minute), State: fsrs.Relearning},
+			settings: defaults,
+			want:     true,
+		},
+		{
+			name:     "uses the configured time zone to split the days",
+			card:     flashcard.Card{Due: now.Add(8 * time.Hour), State: fsrs.Review},
+			settings: flashcard.Settings{DayStartsAt: 4, Timezone: "America/Sao_Paulo"},
+			want:     true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, tt.card.IsDue(now))
+			assert.Equal(t, tt.want, tt.card.IsDue(now, tt.settings))
 		})
 	}
 }
@@ -73,6 +116,60 @@ func TestNewCard(t *testing.T) {
 	assert.Zero(t, card.Lapses)
 }
 
+func TestCard_SetDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	card := flashcard.NewCard("question", "answer", now)
+
+	card = card.SetDue(now.AddDate(0, 0, 3))
+
+	assert.Equal(t, now.AddDate(0, 0, 3), card.Due)
+	assert.Equal(t, uint64(3), card.ScheduledDays)
+}
+
+func TestCard_Forget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	card := flashcard.NewCard("question", "answer", now.AddDate(0, 0, -30))
+	card.State = fsrs.Review
+	card.Stability = 20
+	card.Difficulty = 6
+	card.Reps = 12
+	card.Lapses = 9
+	card.Leech = true
+	card.Suspended = true
+	card = card.AddStats(flashcard.Stats{Rating: fsrs.Good})
+
+	t.Run(
+		"resets the card to new", func(t *testing.T) {
+			got := card.Forget(now, false)
+
+			assert.Equal(t, card.ID, got.ID)
+			assert.Equal(t, fsrs.New, got.State)
+			assert.Equal(t, now, got.Due)
+			assert.Equal(t, now, got.LastReview)
+			assert.Zero(t, got.Stability)
+			assert.Zero(t, got.Difficulty)
+			assert.Zero(t, got.Reps)
+			assert.Zero(t, got.Lapses)
+			assert.False(t, got.Leech)
+			assert.True(t, got.Suspended)
+			assert.Empty(t, got.Stats)
+		},
+	)
+
+	t.Run(
+		"keeps the stats", func(t *testing.T) {
+			got := card.Forget(now, true)
+
+			assert.Equal(t, fsrs.New, got.State)
+			assert.Equal(t, card.Stats, got.Stats)
+		},
+	)
+}
+
 func TestReviewScore_String(t *testing.T) {
 	t.Parallel()
 
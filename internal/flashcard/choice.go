@@ -0,0 +1,59 @@
+package flashcard
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// DrawnChoices is the number of options, the answer included, of the multiple choice cards
+// without distractors of their own, which are drawn from the answers of the other cards.
+const DrawnChoices = 4
+
+// Choices returns the options of a multiple choice card shuffled with the seed: the answer and the
+// distractors of the card, or the answers of other cards of the deck when the card has none.
+func (d Deck) Choices(card Card, seed int64) []string {
+	random := rand.New(rand.NewSource(seed))
+
+	distractors := card.Distractors
+	if len(distractors) == 0 {
+		correct := strings.TrimSpace(card.Answer)
+		for _, c := range d.Cards {
+			answer := strings.TrimSpace(c.Answer)
+			if c.ID == card.ID || c.IsCloze() || answer == "" || answer == correct || slices.Contains(distractors, answer) {
+				continue
+			}
+			distractors = append(distractors, answer)
+		}
+		random.Shuffle(len(distractors), func(i, j int) { distractors[i], distractors[j] = distractors[j], distractors[i] })
+		distractors = distractors[:min(len(distractors), DrawnChoices-1)]
+	}
+
+	choices := append([]string{card.Answer}, distractors...)
+	random.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+	return choices
+}
+
+// GradeChoice scores the chosen option, good when it is the answer and again otherwise.
+func (c Card) GradeChoice(choice string) ReviewScore {
+	if choice == c.Answer {
+		return ReviewScoreGood
+	}
+	return ReviewScoreAgain
+}
+
+// SetChoices turns the card into a multiple choice card with the given distractors,
+// drawn from the deck when there are none, or back into a self graded card.
+func (d Deck) SetChoices(card Card, multipleChoice bool, distractors []string) (Deck, Card) {
+	card.MultipleChoice = multipleChoice
+	card.Distractors = nil
+	if multipleChoice {
+		correct := strings.TrimSpace(card.Answer)
+		for _, distractor := range distractors {
+			if distractor = strings.TrimSpace(distractor); distractor != "" && distractor != correct {
+				card.Distractors = append(card.Distractors, distractor)
+			}
+		}
+	}
+	return d.Change(card), card
+}
@@ -0,0 +1,86 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestDeck_Choices(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	cards := []flashcard.Card{
+		flashcard.NewCard("Capital of France", "Paris", now),
+		flashcard.NewCard("Capital of Spain", "Madrid", now),
+		flashcard.NewCard("Capital of Italy", "Rome", now),
+		flashcard.NewCard("Capital of Germany", "Berlin", now),
+		flashcard.NewCard("Capital of Portugal", "Lisbon", now),
+		flashcard.NewCard("Capital of France, again", "Paris", now),
+	}
+	deck, err := flashcard.NewDeck("choices", testclock.New(now), cards)
+	require.NoError(t, err)
+
+	t.Run(
+		"uses the distractors of the card", func(t *testing.T) {
+			deck, card := deck.SetChoices(deck.Cards[0], true, []string{" Lyon ", "", "Paris", "Nice"})
+
+			choices := deck.Choices(card, 1)
+
+			assert.Equal(t, []string{"Lyon", "Nice"}, card.Distractors)
+			assert.ElementsMatch(t, []string{"Paris", "Lyon", "Nice"}, choices)
+			assert.Equal(t, choices, deck.Choices(card, 1))
+		},
+	)
+
+	t.Run(
+		"draws the distractors from the other answers", func(t *testing.T) {
+			deck, card := deck.SetChoices(deck.Cards[0], true, nil)
+
+			choices := deck.Choices(card, 1)
+
+			require.Len(t, choices, flashcard.DrawnChoices)
+			assert.Contains(t, choices, "Paris")
+			assert.Subset(t, []string{"Paris", "Madrid", "Rome", "Berlin", "Lisbon"}, choices)
+		},
+	)
+
+	t.Run(
+		"leaves out the answer surrounded by spaces", func(t *testing.T) {
+			deck, card := deck.Rewrite(deck.Cards[0], "Capital of France", "Paris\n")
+			deck, card = deck.SetChoices(card, true, []string{"Paris", "Lyon"})
+
+			assert.Equal(t, []string{"Lyon"}, card.Distractors)
+
+			deck, card = deck.SetChoices(card, true, nil)
+			for seed := range int64(10) {
+				assert.NotContains(t, deck.Choices(card, seed), "Paris")
+			}
+		},
+	)
+
+	t.Run(
+		"turns the card back into a self graded card", func(t *testing.T) {
+			deck, card := deck.SetChoices(deck.Cards[0], true, []string{"Lyon"})
+
+			_, card = deck.SetChoices(card, false, []string{"Lyon"})
+
+			assert.False(t, card.MultipleChoice)
+			assert.Empty(t, card.Distractors)
+		},
+	)
+}
+
+func TestCard_GradeChoice(t *testing.T) {
+	t.Parallel()
+
+	card := flashcard.NewCard("Capital of France", "Paris", time.Now())
+
+	assert.Equal(t, flashcard.ReviewScoreGood, card.GradeChoice("Paris"))
+	assert.Equal(t, flashcard.ReviewScoreAgain, card.GradeChoice("Lyon"))
+}
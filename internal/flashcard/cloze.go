@@ -0,0 +1,163 @@
+package flashcard
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	nanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// ErrNoCloze is returned when a cloze text has no cloze deletions.
+var ErrNoCloze = errors.New("no cloze deletions")
+
+// clozePattern matches the {{c1::text}} and {{c1::text::hint}} deletions.
+var clozePattern = regexp.MustCompile(`(?s)\{\{c(\d+)::(.*?)(?:::([^{}]*?))?\}\}`)
+
+// HasCloze reports whether the text has cloze deletions.
+func HasCloze(text string) bool {
+	return len(ClozeIndexes(text)) > 0
+}
+
+// ClozeIndexes returns the sorted indexes of the cloze deletions in the text, each one once.
+func ClozeIndexes(text string) []int {
+	var indexes []int
+	for _, match := range clozePattern.FindAllStringSubmatch(text, -1) {
+		index, err := strconv.Atoi(match[1])
+		if err != nil || index < 1 || slices.Contains(indexes, index) {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes
+}
+
+// renderCloze shows the deletions of the other indexes as plain text and the ones of the index
+// hidden, or highlighted when revealed.
+func renderCloze(text string, index int, reveal bool) string {
+	return clozePattern.ReplaceAllStringFunc(
+		text, func(deletion string) string {
+			match := clozePattern.FindStringSubmatch(deletion)
+			if match[1] != strconv.Itoa(index) {
+				return match[2]
+			}
+			if reveal {
+				return "**" + match[2] + "**"
+			}
+			if hint := strings.TrimSpace(match[3]); hint != "" {
+				return "**[" + hint + "]**"
+			}
+			return "**[...]**"
+		},
+	)
+}
+
+// IsCloze reports whether the card was generated from a cloze text.
+func (c Card) IsCloze() bool {
+	return c.Cloze > 0
+}
+
+// Front returns the text shown as the question, the cloze text with its deletion hidden for cloze cards.
+func (c Card) Front() string {
+	if !c.IsCloze() {
+		return c.Question
+	}
+	return renderCloze(c.Question, c.Cloze, false)
+}
+
+// Back returns the text shown as the answer, the cloze text with its deletion highlighted
+// followed by the extra text for cloze cards.
+func (c Card) Back() string {
+	if !c.IsCloze() {
+		return c.Answer
+	}
+
+	back := renderCloze(c.Question, c.Cloze, true)
+	if strings.TrimSpace(c.Answer) != "" {
+		back += "\n\n" + c.Answer
+	}
+	return back
+}
+
+// AddCloze adds one card for each cloze deletion index of the text, sharing the same note.
+// The extra text is shown with the answer.
+func (d Deck) AddCloze(text, extra string) (Deck, []Card, error) {
+	indexes := ClozeIndexes(text)
+	if len(indexes) == 0 {
+		return d, nil, ErrNoCloze
+	}
+
+	note := nanoid.Must()
+	cards := make([]Card, 0, len(indexes))
+	for _, index := range indexes {
+		card := NewCard(text, extra, d.clock.Now())
+		card.Note, card.Cloze = note, index
+		cards = append(cards, card)
+	}
+
+	d.Cards = append(slices.Clip(d.Cards), cards...)
+	return d, cards, nil
+}
+
+// Siblings returns the cards generated from the same cloze text as the card, the card included.
+func (d Deck) Siblings(card Card) []Card {
+	if card.Note == "" {
+		return []Card{card}
+	}
+
+	var siblings []Card
+	for _, c := range d.Cards {
+		if c.Note == card.Note {
+			siblings = append(siblings, c)
+		}
+	}
+	return siblings
+}
+
+// ChangeCloze rewrites the cloze text of the card and its siblings, keeping the schedule of
+// the indexes still in the text, adding cards for the new ones and removing the ones left out.
+// It returns the cards of the note after the change.
+func (d Deck) ChangeCloze(card Card, text, extra string) (Deck, []Card, error) {
+	indexes := ClozeIndexes(text)
+	if len(indexes) == 0 {
+		return d, nil, ErrNoCloze
+	}
+
+	note := card.Note
+	if note == "" {
+		note = nanoid.Must()
+	}
+
+	existing := make(map[int]bool)
+	cards := make([]Card, 0, len(d.Cards))
+	var changed []Card
+	for _, c := range d.Cards {
+		isSibling := c.ID == card.ID || card.Note != "" && c.Note == card.Note
+		switch {
+		case !isSibling:
+			cards = append(cards, c)
+		case slices.Contains(indexes, c.Cloze) && !existing[c.Cloze]:
+			existing[c.Cloze] = true
+			c = rewrite(c, text, extra)
+			c.Note = note
+			cards = append(cards, c)
+			changed = append(changed, c)
+		}
+	}
+
+	for _, index := range indexes {
+		if existing[index] {
+			continue
+		}
+		c := NewCard(text, extra, d.clock.Now())
+		c.Note, c.Cloze = note, index
+		cards = append(cards, c)
+		changed = append(changed, c)
+	}
+
+	d.Cards = cards
+	return d, changed, nil
+}
@@ -0,0 +1,159 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestClozeIndexes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []int
+	}{
+		{name: "without deletions", text: "plain text", want: nil},
+		{name: "one deletion", text: "{{c1::Go}} was released in 2009", want: []int{1}},
+		{name: "sorted indexes", text: "{{c2::Go}} was released in {{c1::2009}}", want: []int{1, 2}},
+		{name: "repeated index", text: "{{c1::Go}} and {{c1::Rust}} and {{c3::C}}", want: []int{1, 3}},
+		{name: "with hint", text: "{{c1::Go::language}}", want: []int{1}},
+		{name: "invalid index", text: "{{c0::Go}}", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, flashcard.ClozeIndexes(tt.text))
+				assert.Equal(t, tt.want != nil, flashcard.HasCloze(tt.text))
+			},
+		)
+	}
+}
+
+func TestCard_Front(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("cloze", testclock.New(now), nil)
+	require.NoError(t, err)
+
+	deck, cards, err := deck.AddCloze("{{c1::Go}} was released in {{c2::2009::year}}", "By Google.")
+	require.NoError(t, err)
+	require.Len(t, cards, 2)
+
+	assert.Equal(t, "**[...]** was released in 2009", cards[0].Front())
+	assert.Equal(t, "**Go** was released in 2009\n\nBy Google.", cards[0].Back())
+	assert.Equal(t, "Go was released in **[year]**", cards[1].Front())
+	assert.Equal(t, "Go was released in **2009**\n\nBy Google.", cards[1].Back())
+
+	card := flashcard.NewCard("question", "answer", now)
+	assert.Equal(t, "question", card.Front())
+	assert.Equal(t, "answer", card.Back())
+}
+
+func TestDeck_AddCloze(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("cloze", testclock.New(now), nil)
+	require.NoError(t, err)
+
+	t.Run(
+		"adds a card for each index sharing the note", func(t *testing.T) {
+			deck, cards, err := deck.AddCloze("{{c1::Go}} was released in {{c2::2009}}", "")
+			require.NoError(t, err)
+
+			assert.Equal(t, 2, deck.Total())
+			assert.Equal(t, 1, cards[0].Cloze)
+			assert.Equal(t, 2, cards[1].Cloze)
+			assert.NotEmpty(t, cards[0].Note)
+			assert.Equal(t, cards[0].Note, cards[1].Note)
+			assert.NotEqual(t, cards[0].ID, cards[1].ID)
+			assert.Len(t, deck.Siblings(cards[0]), 2)
+		},
+	)
+
+	t.Run(
+		"returns error without deletions", func(t *testing.T) {
+			_, _, err := deck.AddCloze("Go was released in 2009", "")
+
+			assert.ErrorIs(t, err, flashcard.ErrNoCloze)
+		},
+	)
+}
+
+func TestDeck_ChangeCloze(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+
+	newClozeDeck := func(t *testing.T) (flashcard.Deck, []flashcard.Card) {
+		t.Helper()
+
+		deck, err := flashcard.NewDeck("cloze", c, []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+		require.NoError(t, err)
+		deck, cards, err := deck.AddCloze("{{c1::Go}} was released in {{c2::2009}}", "")
+		require.NoError(t, err)
+
+		// the first cloze was studied, so it has a schedule to keep.
+		review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Query: "is:new"}), flashcard.WithOrder(flashcard.OrderDue))
+		for review.Left() > 0 {
+			review, err = review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+		}
+		return review.Deck, cards
+	}
+
+	t.Run(
+		"updates the siblings keeping their schedule", func(t *testing.T) {
+			deck, cards := newClozeDeck(t)
+			before := getCard(deck, cards[0].ID)
+			require.Equal(t, fsrs.Review, before.State)
+
+			deck, changed, err := deck.ChangeCloze(cards[1], "{{c1::Golang}} was released in {{c2::2009}}", "extra")
+			require.NoError(t, err)
+
+			require.Len(t, changed, 2)
+			after := getCard(deck, cards[0].ID)
+			assert.Equal(t, "**[...]** was released in 2009", after.Front())
+			assert.Equal(t, "extra", after.Answer)
+			assert.Equal(t, before.Stability, after.Stability)
+			assert.Equal(t, before.Due, after.Due)
+			assert.Equal(t, 3, deck.Total())
+		},
+	)
+
+	t.Run(
+		"adds and removes the cards of the indexes changed", func(t *testing.T) {
+			deck, cards := newClozeDeck(t)
+
+			deck, changed, err := deck.ChangeCloze(cards[0], "{{c1::Go}} was released by {{c3::Google}}", "")
+			require.NoError(t, err)
+
+			require.Len(t, changed, 2)
+			assert.Equal(t, cards[0].ID, changed[0].ID)
+			assert.Equal(t, 3, changed[1].Cloze)
+			assert.Equal(t, fsrs.New, changed[1].State)
+			assert.Empty(t, getCard(deck, cards[1].ID).ID)
+			assert.Equal(t, 3, deck.Total())
+		},
+	)
+
+	t.Run(
+		"returns error without deletions", func(t *testing.T) {
+			deck, cards := newClozeDeck(t)
+
+			_, _, err := deck.ChangeCloze(cards[0], "Go was released in 2009", "")
+
+			assert.ErrorIs(t, err, flashcard.ErrNoCloze)
+		},
+	)
+}
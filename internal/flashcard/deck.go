@@ -2,9 +2,13 @@ package flashcard
 
 import (
 	"errors"
+	"math"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/avelino/slugify"
+	"github.com/open-spaced-repetition/go-fsrs/v3"
 
 	"github.com/eliostvs/lembrol/internal/clock"
 )
@@ -20,10 +24,11 @@ func NewDeck(name string, clock clock.Clock, cards []Card) (deck Deck, err error
 	}
 
 	deck = Deck{
-		ID:    slugify.Slugify(name),
-		Name:  name,
-		Cards: cards,
-		clock: clock,
+		ID:       slugify.Slugify(name),
+		Name:     name,
+		Cards:    cards,
+		Settings: DefaultSettings(),
+		clock:    clock,
 	}
 
 	return deck, nil
@@ -31,11 +36,19 @@ func NewDeck(name string, clock clock.Clock, cards []Card) (deck Deck, err error
 
 // Deck represents a named collection of cards.
 type Deck struct {
-	Name  string `json:"name" validate:"required"`
-	Cards []Card `json:"cards"`
+	Name     string   `json:"name" validate:"required"`
+	Cards    []Card   `json:"cards"`
+	Settings Settings `json:"settings"`
+	Filters  []Filter `json:"filters,omitempty" validate:"dive"`
+	// NoteTypes are the note types defined by the deck, besides the built-in Basic.
+	NoteTypes []NoteType `json:"note_types,omitempty" validate:"dive"`
+	// Session is the review interrupted before it was finished.
+	Session *Session `json:"session,omitempty"`
 
 	ID    string
 	clock clock.Clock
+	// mediaDir is set by the repository, which knows where the deck is saved.
+	mediaDir string
 }
 
 // List returns a collection of cards order by the time of the last review and question.
@@ -57,7 +70,10 @@ func (d Deck) sort(cards []Card) {
 	)
 }
 
-// DueCards returns a collection of cards that needs review.
+// DueCards returns a collection of cards that needs review today.
+// New and review cards are capped by the deck daily limits,
+// discounting the cards already studied today. The limits keep
+// the most overdue reviews and the oldest new cards, listed in the deck order.
 func (d Deck) DueCards() []Card {
 	cards := make([]Card, 0, len(d.Cards))
 
@@ -67,17 +83,152 @@ func (d Deck) DueCards() []Card {
 	}
 
 	date := d.clock.Now()
-	for _, card := range d.Cards {
-		if card.IsDue(date) {
+	studiedNew, studiedReviews := d.studiedOn(date)
+	newLeft := max(0, d.Settings.NewPerDay-studiedNew)
+	reviewsLeft := max(0, d.Settings.ReviewsPerDay-studiedReviews)
+
+	due := make(map[string]bool)
+	for _, card := range d.byPriority() {
+		if card.Suspended || card.IsBuried(date) || !card.IsDue(date, d.Settings) {
+			continue
+		}
+
+		switch card.State {
+		case fsrs.New:
+			if newLeft == 0 {
+				continue
+			}
+			newLeft--
+
+		case fsrs.Review:
+			if reviewsLeft == 0 {
+				continue
+			}
+			reviewsLeft--
+		}
+
+		due[card.ID] = true
+	}
+
+	for _, card := range d.List() {
+		if due[card.ID] {
 			cards = append(cards, card)
 		}
 	}
+	return cards
+}
 
-	d.sort(cards)
+// byPriority returns the cards sorted by due date, with the new cards sorted by
+// creation date instead, which is when they were last reviewed until studied.
+func (d Deck) byPriority() []Card {
+	priority := func(c Card) time.Time {
+		if c.State == fsrs.New {
+			return c.LastReview
+		}
+		return c.Due
+	}
 
+	cards := d.List()
+	slices.SortStableFunc(cards, func(a, b Card) int {
+		return priority(a).Compare(priority(b))
+	})
 	return cards
 }
 
+// studiedOn counts the new cards introduced and the review cards answered on the study day of t.
+// A review is counted against the state the card had before it was answered.
+func (d Deck) studiedOn(t time.Time) (newCards, reviews int) {
+	for _, card := range d.Cards {
+		for i, stats := range card.Stats {
+			if !d.Settings.SameStudyDay(stats.LastReview, t) {
+				continue
+			}
+
+			switch {
+			case i == 0:
+				newCards++
+			case card.Stats[i-1].State == fsrs.Review:
+				reviews++
+			}
+		}
+	}
+
+	return newCards, reviews
+}
+
+// DueCounts holds the number of cards left to study today grouped by state.
+type DueCounts struct {
+	New      int
+	Review   int
+	Learning int
+}
+
+// DueCounts returns how many new, review and learning cards are left to study today.
+func (d Deck) DueCounts() DueCounts {
+	var counts DueCounts
+
+	for _, card := range d.DueCards() {
+		switch card.State {
+		case fsrs.New:
+			counts.New++
+		case fsrs.Review:
+			counts.Review++
+		default:
+			counts.Learning++
+		}
+	}
+
+	return counts
+}
+
+// Workload counts the cards scheduled to each study day, keyed by the instant the day starts.
+type Workload map[time.Time]int
+
+// Workload returns how many cards are scheduled to each study day.
+// New and suspended cards are not part of the workload.
+func (d Deck) Workload() Workload {
+	workload := make(Workload)
+	for _, card := range d.Cards {
+		if card.State == fsrs.New || card.Suspended {
+			continue
+		}
+		workload[d.Settings.StudyDay(card.Due)]++
+	}
+	return workload
+}
+
+// Forecast returns how many cards are due on each of the next days, starting today.
+// The cards overdue are counted today.
+func (d Deck) Forecast(days int) []int {
+	today := d.Settings.StudyDay(d.clock.Now())
+	forecast := make([]int, days)
+	for day, total := range d.Workload() {
+		index := 0
+		if day.After(today) {
+			index = int(math.Round(day.Sub(today).Hours() / 24))
+		}
+		if index < days {
+			forecast[index] += total
+		}
+	}
+	return forecast
+}
+
+// NextDue returns when the next review card is due, or zero when the deck has no review cards.
+// New and suspended cards are left out.
+func (d Deck) NextDue() time.Time {
+	var next time.Time
+	for _, card := range d.Cards {
+		if card.State == fsrs.New || card.Suspended {
+			continue
+		}
+		if next.IsZero() || card.Due.Before(next) {
+			next = card.Due
+		}
+	}
+	return next
+}
+
 // HasDueCards says if the deck has due cards.
 func (d Deck) HasDueCards() bool {
 	return len(d.DueCards()) > 0
@@ -109,8 +260,153 @@ func (d Deck) Change(updated Card) Deck {
 	return d
 }
 
-// Remove excludes card from the deck.
+// Rewrite replaces the question and answer of the card, keeping its schedule and stats.
+func (d Deck) Rewrite(card Card, question, answer string) (Deck, Card) {
+	card = rewrite(card, question, answer)
+	return d.Change(card), card
+}
+
+// Suspend takes the card out of the reviews until it is unsuspended.
+func (d Deck) Suspend(card Card) (Deck, Card) {
+	card.Suspended = true
+	return d.Change(card), card
+}
+
+// Unsuspend brings a suspended card back to the reviews.
+func (d Deck) Unsuspend(card Card) (Deck, Card) {
+	card.Suspended = false
+	return d.Change(card), card
+}
+
+// Bury hides the card from the reviews until the next study day starts.
+func (d Deck) Bury(card Card) (Deck, Card) {
+	card.BuriedUntil = d.Settings.StudyDay(d.clock.Now()).AddDate(0, 0, 1)
+	return d.Change(card), card
+}
+
+// Unbury brings a buried card back to the reviews.
+func (d Deck) Unbury(card Card) (Deck, Card) {
+	card.BuriedUntil = time.Time{}
+	return d.Change(card), card
+}
+
+// SetDue schedules the card to the given instant.
+func (d Deck) SetDue(card Card, due time.Time) (Deck, Card) {
+	card = card.SetDue(due)
+	return d.Change(card), card
+}
+
+// Forget resets the card to the new state, see Card.Forget.
+func (d Deck) Forget(card Card, keepStats bool) (Deck, Card) {
+	card = card.Forget(d.clock.Now(), keepStats)
+	return d.Change(card), card
+}
+
+// Retrievability bounds used when moving many cards at once.
+// Postponed cards are not pushed beyond the point their recall probability drops below minPostponeRetrievability,
+// and cards are only advanced while their recall probability is below maxAdvanceRetrievability,
+// otherwise the early review would barely strengthen the memory.
+const (
+	minPostponeRetrievability = 0.7
+	maxAdvanceRetrievability  = 0.95
+)
+
+// Postpone pushes the review cards due in the next days out by up to days.
+// Each card is postponed as far as its retrievability stays above a safe floor,
+// so the stable cards wait longer than the fragile ones.
+// It returns the deck and the number of postponed cards.
+func (d Deck) Postpone(days int) (Deck, int) {
+	now := d.clock.Now()
+	scheduler := DefaultScheduler()
+	until := now.AddDate(0, 0, days)
+
+	var postponed int
+	for _, card := range d.Cards {
+		if card.State != fsrs.Review || card.Suspended || !card.IsDue(until, d.Settings) {
+			continue
+		}
+
+		from := card.Due
+		if from.Before(now) {
+			from = now
+		}
+
+		for delay := days; delay > 0; delay-- {
+			due := from.AddDate(0, 0, delay)
+			if scheduler.GetRetrievability(card, due) >= minPostponeRetrievability {
+				d = d.Change(card.SetDue(due))
+				postponed++
+				break
+			}
+		}
+	}
+
+	return d, postponed
+}
+
+// Advance brings the review cards due in the next days to today.
+// Cards which are still very likely to be remembered are left alone.
+// It returns the deck and the number of advanced cards.
+func (d Deck) Advance(days int) (Deck, int) {
+	now := d.clock.Now()
+	scheduler := DefaultScheduler()
+	until := now.AddDate(0, 0, days)
+
+	var advanced int
+	for _, card := range d.Cards {
+		if card.State != fsrs.Review || card.Suspended || card.IsDue(now, d.Settings) || !card.IsDue(until, d.Settings) {
+			continue
+		}
+
+		if scheduler.GetRetrievability(card, now) < maxAdvanceRetrievability {
+			d = d.Change(card.SetDue(now))
+			advanced++
+		}
+	}
+
+	return d, advanced
+}
+
+// Search returns the cards matching the query in the same order as List.
+func (d Deck) Search(query Query) []Card {
+	now := d.clock.Now()
+
+	var cards []Card
+	for _, card := range d.List() {
+		if query.Match(card, now, d.Settings) {
+			cards = append(cards, card)
+		}
+	}
+	return cards
+}
+
+// Leeches returns the cards marked as leeches ordered by the number of lapses.
+func (d Deck) Leeches() []Card {
+	var cards []Card
+	for _, card := range d.List() {
+		if card.Leech {
+			cards = append(cards, card)
+		}
+	}
+
+	sort.SliceStable(
+		cards, func(i, j int) bool {
+			return cards[i].Lapses > cards[j].Lapses
+		},
+	)
+
+	return cards
+}
+
+// Remove excludes card from the deck, along with its reverse.
 func (d Deck) Remove(card Card) Deck {
+	if reverse, ok := d.Reverse(card); ok {
+		d = d.remove(reverse)
+	}
+	return d.remove(card)
+}
+
+func (d Deck) remove(card Card) Deck {
 	cards := make([]Card, 0, len(d.Cards))
 
 	for _, c := range d.Cards {
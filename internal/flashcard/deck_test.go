@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/open-spaced-repetition/go-fsrs/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -144,6 +145,290 @@ func TestDeck_DueCards(t *testing.T) {
 	)
 }
 
+func TestDeck_DueCards_Limits(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 15, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+
+	newCard := func(id string) flashcard.Card {
+		return flashcard.Card{ID: id, Question: id, State: fsrs.New, Due: yesterday, LastReview: yesterday}
+	}
+	reviewCard := func(id string) flashcard.Card {
+		return flashcard.Card{ID: id, Question: id, State: fsrs.Review, Due: yesterday, LastReview: yesterday.Add(-time.Hour)}
+	}
+	learningCard := func(id string) flashcard.Card {
+		return flashcard.Card{ID: id, Question: id, State: fsrs.Learning, Due: yesterday, LastReview: yesterday.Add(-2 * time.Hour)}
+	}
+	studiedToday := func(id string, states ...fsrs.State) flashcard.Card {
+		card := flashcard.Card{ID: id, Question: id, State: fsrs.Review, Due: now.Add(48 * time.Hour), LastReview: now}
+		for _, state := range states {
+			card = card.AddStats(flashcard.Stats{Rating: fsrs.Good, State: state, LastReview: now.Add(-time.Hour)})
+		}
+		return card
+	}
+
+	tests := []struct {
+		name     string
+		settings flashcard.Settings
+		cards    []flashcard.Card
+		want     flashcard.DueCounts
+	}{
+		{
+			name:     "caps new cards",
+			settings: flashcard.Settings{NewPerDay: 2, ReviewsPerDay: 10},
+			cards:    []flashcard.Card{newCard("a"), newCard("b"), newCard("c"), reviewCard("d")},
+			want:     flashcard.DueCounts{New: 2, Review: 1},
+		},
+		{
+			name:     "caps review cards",
+			settings: flashcard.Settings{NewPerDay: 10, ReviewsPerDay: 1},
+			cards:    []flashcard.Card{newCard("a"), reviewCard("b"), reviewCard("c")},
+			want:     flashcard.DueCounts{New: 1, Review: 1},
+		},
+		{
+			name:     "does not cap learning cards",
+			settings: flashcard.Settings{NewPerDay: 0, ReviewsPerDay: 0},
+			cards:    []flashcard.Card{newCard("a"), reviewCard("b"), learningCard("c")},
+			want:     flashcard.DueCounts{Learning: 1},
+		},
+		{
+			name:     "discounts new cards introduced today",
+			settings: flashcard.Settings{NewPerDay: 2, ReviewsPerDay: 10},
+			cards:    []flashcard.Card{newCard("a"), newCard("b"), studiedToday("c", fsrs.Learning, fsrs.Review)},
+			want:     flashcard.DueCounts{New: 1},
+		},
+		{
+			name:     "discounts reviews answered today",
+			settings: flashcard.Settings{NewPerDay: 10, ReviewsPerDay: 2},
+			cards: []flashcard.Card{
+				reviewCard("a"),
+				reviewCard("b"),
+				studiedToday("c", fsrs.Review, fsrs.Review),
+			},
+			want: flashcard.DueCounts{Review: 1},
+		},
+		{
+			name:     "counts cards studied before the day boundary in the previous day",
+			settings: flashcard.Settings{NewPerDay: 1, ReviewsPerDay: 10, DayStartsAt: 4},
+			cards: []flashcard.Card{
+				newCard("a"),
+				flashcard.Card{ID: "b", State: fsrs.Learning, Due: now.Add(time.Hour)}.
+					AddStats(flashcard.Stats{State: fsrs.Learning, LastReview: now.Add(-12 * time.Hour)}),
+			},
+			want: flashcard.DueCounts{New: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				deck, err := flashcard.NewDeck("Limits", testclock.New(now), tt.cards)
+				require.NoError(t, err)
+				deck.Settings = tt.settings
+
+				assert.Equal(t, tt.want, deck.DueCounts())
+				assert.Len(t, deck.DueCards(), tt.want.New+tt.want.Review+tt.want.Learning)
+			},
+		)
+	}
+}
+
+func TestDeck_DueCards_Priority(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 15, 0, 0, 0, time.UTC)
+
+	newCard := func(id string, created time.Time) flashcard.Card {
+		return flashcard.Card{ID: id, Question: id, State: fsrs.New, Due: created, LastReview: created}
+	}
+	reviewCard := func(id string, lastReview, due time.Time) flashcard.Card {
+		return flashcard.Card{ID: id, Question: id, State: fsrs.Review, Due: due, LastReview: lastReview}
+	}
+
+	cards := []flashcard.Card{
+		newCard("newest", now.Add(-time.Hour)),
+		newCard("oldest", now.AddDate(0, 0, -3)),
+		reviewCard("recent", now.AddDate(0, 0, -1), now.Add(-time.Hour)),
+		reviewCard("late", now.AddDate(0, 0, -5), now.AddDate(0, 0, -2)),
+		reviewCard("overdue", now.AddDate(0, 0, -10), now.AddDate(0, 0, -5)),
+	}
+	deck, err := flashcard.NewDeck("Priority", testclock.New(now), cards)
+	require.NoError(t, err)
+	deck.Settings = flashcard.Settings{NewPerDay: 1, ReviewsPerDay: 2}
+
+	assertQuestions(t, []string{"oldest", "late", "overdue"}, deck.DueCards())
+}
+
+func TestDeck_Suspend(t *testing.T) {
+	t.Parallel()
+
+	deck := newTestDeck(t, largeDeck, testclock.New(afterOldestCard))
+	card := deck.DueCards()[0]
+
+	deck, card = deck.Suspend(card)
+
+	assert.True(t, card.Suspended)
+	assert.Empty(t, deck.DueCards())
+	assert.Equal(t, 7, deck.Total())
+
+	deck, card = deck.Unsuspend(card)
+
+	assert.False(t, card.Suspended)
+	assert.Contains(t, deck.DueCards(), card)
+}
+
+func TestDeck_Bury(t *testing.T) {
+	t.Parallel()
+
+	deck := newTestDeck(t, largeDeck, testclock.New(afterOldestCard))
+	card := deck.DueCards()[0]
+
+	deck, card = deck.Bury(card)
+
+	assert.Equal(t, time.Date(2021, 1, 4, flashcard.DefaultDayStartsAt, 0, 0, 0, time.UTC), card.BuriedUntil)
+	assert.Empty(t, deck.DueCards())
+
+	nextDay, err := flashcard.NewDeck(deck.Name, testclock.New(card.BuriedUntil), deck.Cards)
+	require.NoError(t, err)
+	assert.Contains(t, nextDay.DueCards(), card)
+
+	deck, card = deck.Unbury(card)
+
+	assert.True(t, card.BuriedUntil.IsZero())
+	assert.Contains(t, deck.DueCards(), card)
+}
+
+func TestDeck_Leeches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	few := flashcard.NewCard("few", "answer", now)
+	few.Leech, few.Lapses = true, 8
+	many := flashcard.NewCard("many", "answer", now)
+	many.Leech, many.Lapses = true, 12
+	deck, err := flashcard.NewDeck(
+		"leech", testclock.New(now), []flashcard.Card{few, flashcard.NewCard("ok", "answer", now), many},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []flashcard.Card{many, few}, deck.Leeches())
+}
+
+func TestDeck_Forget(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	deck := newTestDeck(t, largeDeck, testclock.New(now))
+	card := deck.List()[0]
+
+	deck, card = deck.Forget(card, true)
+
+	assert.Equal(t, fsrs.New, card.State)
+	assert.Equal(t, card, getCard(deck, card.ID))
+}
+
+func TestDeck_SetDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	deck := newTestDeck(t, largeDeck, testclock.New(now))
+	card := deck.List()[0]
+
+	deck, card = deck.SetDue(card, now.AddDate(0, 0, 10))
+
+	assert.Equal(t, now.AddDate(0, 0, 10), getCard(deck, card.ID).Due)
+	assert.NotContains(t, deck.DueCards(), card)
+}
+
+func TestDeck_Postpone(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	stable := newReviewCard("stable", 100, now.AddDate(0, 0, -100), now)
+	fragile := newReviewCard("fragile", 2, now.AddDate(0, 0, -2), now)
+	forgotten := newReviewCard("forgotten", 0.2, now.AddDate(0, 0, -1), now)
+	future := newReviewCard("future", 100, now.AddDate(0, 0, -50), now.AddDate(0, 0, 30))
+	unseen := flashcard.NewCard("new", "answer", now)
+	deck, err := flashcard.NewDeck(
+		"postpone", testclock.New(now), []flashcard.Card{stable, fragile, forgotten, future, unseen},
+	)
+	require.NoError(t, err)
+
+	deck, postponed := deck.Postpone(7)
+
+	assert.Equal(t, 2, postponed)
+	assert.Equal(t, now.AddDate(0, 0, 7), getCard(deck, stable.ID).Due)
+	assert.Equal(t, now.AddDate(0, 0, 6), getCard(deck, fragile.ID).Due)
+	assert.Equal(t, forgotten.Due, getCard(deck, forgotten.ID).Due)
+	assert.Equal(t, future.Due, getCard(deck, future.ID).Due)
+	assert.Equal(t, unseen.Due, getCard(deck, unseen.ID).Due)
+}
+
+func TestDeck_Advance(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	soon := newReviewCard("soon", 10, now.AddDate(0, 0, -8), now.AddDate(0, 0, 2))
+	strong := newReviewCard("strong", 100, now.AddDate(0, 0, -10), now.AddDate(0, 0, 3))
+	far := newReviewCard("far", 10, now.AddDate(0, 0, -8), now.AddDate(0, 0, 30))
+	deck, err := flashcard.NewDeck("advance", testclock.New(now), []flashcard.Card{soon, strong, far})
+	require.NoError(t, err)
+
+	deck, advanced := deck.Advance(7)
+
+	assert.Equal(t, 1, advanced)
+	assert.Equal(t, now, getCard(deck, soon.ID).Due)
+	assert.Equal(t, strong.Due, getCard(deck, strong.ID).Due)
+	assert.Equal(t, far.Due, getCard(deck, far.ID).Due)
+}
+
+func TestDeck_Forecast(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	suspended := newReviewCard("suspended", 10, now, now.AddDate(0, 0, 1))
+	suspended.Suspended = true
+	deck, err := flashcard.NewDeck(
+		"forecast", testclock.New(now), []flashcard.Card{
+			newReviewCard("overdue", 10, now.AddDate(0, 0, -10), now.AddDate(0, 0, -2)),
+			newReviewCard("today", 10, now.AddDate(0, 0, -10), now.Add(time.Hour)),
+			newReviewCard("tomorrow", 10, now, now.AddDate(0, 0, 1)),
+			newReviewCard("late night", 10, now, now.AddDate(0, 0, 2).Add(15*time.Hour)),
+			newReviewCard("far", 10, now, now.AddDate(0, 0, 30)),
+			flashcard.NewCard("new", "answer", now),
+			suspended,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{2, 1, 1, 0}, deck.Forecast(4))
+}
+
+func TestDeck_NextDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	suspended := newReviewCard("suspended", 10, now, now.Add(time.Hour))
+	suspended.Suspended = true
+	newCard := flashcard.NewCard("new", "answer", now)
+	deck, err := flashcard.NewDeck(
+		"next", testclock.New(now), []flashcard.Card{
+			newReviewCard("later", 10, now, now.AddDate(0, 0, 3)),
+			newReviewCard("tomorrow", 10, now, now.AddDate(0, 0, 1)),
+			newCard,
+			suspended,
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, now.AddDate(0, 0, 1), deck.NextDue())
+
+	deck, err = flashcard.NewDeck("new", testclock.New(now), []flashcard.Card{newCard})
+	require.NoError(t, err)
+
+	assert.Zero(t, deck.NextDue())
+}
+
 func TestDeck_Total(t *testing.T) {
 	t.Parallel()
 
@@ -255,6 +540,23 @@ func TestDeck_Update(t *testing.T) {
 	assert.ElementsMatch(t, newDeck.List(), []flashcard.Card{card})
 }
 
+func TestDeck_Rewrite(t *testing.T) {
+	t.Parallel()
+
+	deck := newTestDeck(t, emptyDeck, clock.New())
+	deck, card := deck.Add("Question", "Answer")
+	card.Leech, card.Lapses = true, 8
+	deck = deck.Change(card)
+
+	deck, card = deck.Rewrite(card, "New Question", "New Answer")
+
+	assert.Equal(t, "New Question", card.Question)
+	assert.Equal(t, "New Answer", card.Answer)
+	assert.False(t, card.Leech)
+	assert.Equal(t, uint64(8), card.Lapses)
+	assert.Equal(t, card, getCard(deck, card.ID))
+}
+
 /*
  Test Utilities
 */
@@ -297,3 +599,13 @@ func assertQuestions(t *testing.T, expected []string, cards []flashcard.Card) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func newReviewCard(question string, stability float64, lastReview, due time.Time) flashcard.Card {
+	card := flashcard.NewCard(question, "answer", lastReview)
+	card.State = fsrs.Review
+	card.Stability = stability
+	card.Difficulty = 5
+	card.Reps = 3
+	card.Due = due
+	return card
+}
@@ -0,0 +1,89 @@
+package flashcard
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidFlag is returned by ParseFlag when the colour is not one of the flags.
+var ErrInvalidFlag = errors.New("invalid flag")
+
+// Flag marks a card with a colour, to come back to it later, like a card that needs a rewrite.
+type Flag string
+
+const (
+	// FlagNone is the flag of the cards not flagged.
+	FlagNone   Flag = ""
+	FlagRed    Flag = "red"
+	FlagOrange Flag = "orange"
+	FlagGreen  Flag = "green"
+	FlagBlue   Flag = "blue"
+	FlagPurple Flag = "purple"
+)
+
+// Flags are the colours a card can be flagged with, in the order they are cycled through.
+var Flags = []Flag{FlagRed, FlagOrange, FlagGreen, FlagBlue, FlagPurple}
+
+// ParseFlag converts the colour to a Flag, with none or an empty string removing the flag.
+func ParseFlag(s string) (Flag, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" || s == "none" {
+		return FlagNone, nil
+	}
+
+	if flag := Flag(s); slices.Contains(Flags, flag) {
+		return flag, nil
+	}
+	return FlagNone, fmt.Errorf("%w: %s", ErrInvalidFlag, s)
+}
+
+// Next returns the flag after this one, going back to no flag after the last colour.
+func (f Flag) Next() Flag {
+	index := slices.Index(Flags, f)
+	if index == len(Flags)-1 {
+		return FlagNone
+	}
+	return Flags[index+1]
+}
+
+func (f Flag) String() string {
+	if f == FlagNone {
+		return "none"
+	}
+	return string(f)
+}
+
+// IsFlagged reports whether the card has a flag.
+func (c Card) IsFlagged() bool {
+	return c.Flag != FlagNone
+}
+
+// SetFlag flags the card only, the other cards of the note keep their own flags.
+func (d Deck) SetFlag(card Card, flag Flag) (Deck, Card) {
+	card.Flag = flag
+	return d.Change(card), card
+}
+
+// SetNotes replaces the personal notes of the card.
+func (d Deck) SetNotes(card Card, notes string) (Deck, Card) {
+	card.Notes = strings.TrimSpace(notes)
+	return d.Change(card), card
+}
+
+// SetFlag flags the current card, which stays in the session.
+func (r Review) SetFlag(flag Flag) (Review, error) {
+	if len(r.queue) == 0 {
+		return Review{}, ErrEmptyReview
+	}
+
+	current := r.queue[0]
+	deck, card := r.decks[current.deck].SetFlag(current.Card, flag)
+	r = r.change(current.deck, deck)
+
+	// the queue is shared with the previous reviews, so the current card is changed in a copy.
+	r.queue = slices.Clone(r.queue)
+	r.queue[0].Card = card
+	return r, nil
+}
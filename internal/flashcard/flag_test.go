@@ -0,0 +1,96 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestParseFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want flashcard.Flag
+		err  bool
+	}{
+		{name: "empty", text: "", want: flashcard.FlagNone},
+		{name: "none", text: "none", want: flashcard.FlagNone},
+		{name: "colour", text: " Red ", want: flashcard.FlagRed},
+		{name: "unknown colour", text: "pink", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				flag, err := flashcard.ParseFlag(tt.text)
+
+				if tt.err {
+					assert.ErrorIs(t, err, flashcard.ErrInvalidFlag)
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tt.want, flag)
+				}
+			},
+		)
+	}
+}
+
+func TestFlag_Next(t *testing.T) {
+	t.Parallel()
+
+	flag := flashcard.FlagNone
+	var cycle []flashcard.Flag
+	for range len(flashcard.Flags) + 1 {
+		flag = flag.Next()
+		cycle = append(cycle, flag)
+	}
+
+	assert.Equal(t, append(flashcard.Flags, flashcard.FlagNone), cycle)
+}
+
+func TestDeck_SetFlag(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, card, reverse := newTagDeck(t, now).AddReversible("dog", "cachorro")
+
+	deck, card = deck.SetFlag(card, flashcard.FlagOrange)
+	deck, card = deck.SetNotes(card, " check the plural \n")
+
+	assert.Equal(t, flashcard.FlagOrange, getCard(deck, card.ID).Flag)
+	assert.Equal(t, "check the plural", getCard(deck, card.ID).Notes)
+	assert.False(t, getCard(deck, reverse.ID).IsFlagged())
+}
+
+func TestReview_SetFlag(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+	review := flashcard.NewReview(newTagDeck(t, now), c)
+
+	flagged, err := review.SetFlag(flashcard.FlagRed)
+	require.NoError(t, err)
+
+	card, err := flagged.Card()
+	require.NoError(t, err)
+	assert.Equal(t, flashcard.FlagRed, card.Flag)
+	assert.Equal(t, flashcard.FlagRed, getCard(flagged.Deck, card.ID).Flag)
+
+	previous, err := review.Card()
+	require.NoError(t, err)
+	assert.False(t, previous.IsFlagged())
+
+	rated, err := flagged.Rate(flashcard.ReviewScoreGood)
+	require.NoError(t, err)
+	assert.Equal(t, flashcard.FlagRed, getCard(rated.Deck, card.ID).Flag)
+
+	_, err = flashcard.NewReview(flashcard.Deck{}, c).SetFlag(flashcard.FlagRed)
+	assert.ErrorIs(t, err, flashcard.ErrEmptyReview)
+}
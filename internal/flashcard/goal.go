@@ -0,0 +1,53 @@
+package flashcard
+
+import "time"
+
+// Goal ends a review after a number of cards or of minutes, whichever comes first.
+// A zero value means no goal.
+type Goal struct {
+	Cards   int `json:"cards,omitempty" validate:"gte=0"`
+	Minutes int `json:"minutes,omitempty" validate:"gte=0"`
+}
+
+// IsZero reports whether the goal has no limits.
+func (g Goal) IsZero() bool {
+	return g.Cards <= 0 && g.Minutes <= 0
+}
+
+// WithGoal ends the review once the goal is reached, even with cards left.
+// The goal counts from when the review is created, so a resumed review starts it over.
+func WithGoal(goal Goal) ReviewOption {
+	return func(r *Review) {
+		r.goal = goal
+	}
+}
+
+// Goal returns the goal of the session.
+func (r Review) Goal() Goal {
+	return r.goal
+}
+
+// GoalProgress returns how close the session is to its goal, from zero to one.
+// With both limits the one closer to the end counts. It is zero without a goal.
+func (r Review) GoalProgress() float64 {
+	var progress float64
+	if r.goal.Cards > 0 {
+		progress = float64(r.Completed-r.goalCompleted) / float64(r.goal.Cards)
+	}
+	if r.goal.Minutes > 0 {
+		limit := time.Duration(r.goal.Minutes) * time.Minute
+		progress = max(progress, float64(r.clock.Now().Sub(r.goalStartedAt))/float64(limit))
+	}
+	return min(max(progress, 0), 1)
+}
+
+// GoalReached reports whether the session reached its goal and should wrap up.
+func (r Review) GoalReached() bool {
+	return !r.goal.IsZero() && r.GoalProgress() >= 1
+}
+
+// startGoal starts counting the goal from now.
+func (r *Review) startGoal() {
+	r.goalStartedAt = r.clock.Now()
+	r.goalCompleted = r.Completed
+}
@@ -0,0 +1,78 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestReview_WithGoal(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run(
+		"is reached after the number of cards", func(t *testing.T) {
+			c := testclock.New(now)
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithGoal(flashcard.Goal{Cards: 2}))
+
+			review, err := review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+			assert.False(t, review.GoalReached())
+			assert.Equal(t, 0.5, review.GoalProgress())
+
+			review, err = review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+			assert.True(t, review.GoalReached())
+			assert.Positive(t, review.Left())
+		},
+	)
+
+	t.Run(
+		"is reached after the number of minutes", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithGoal(flashcard.Goal{Cards: 100, Minutes: 10}))
+
+			c.now = now.Add(5 * time.Minute)
+			assert.Equal(t, 0.5, review.GoalProgress())
+			assert.False(t, review.GoalReached())
+
+			c.now = now.Add(10 * time.Minute)
+			assert.True(t, review.GoalReached())
+		},
+	)
+
+	t.Run(
+		"is never reached without a goal", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c)
+
+			c.now = now.Add(24 * time.Hour)
+
+			assert.True(t, review.Goal().IsZero())
+			assert.Zero(t, review.GoalProgress())
+			assert.False(t, review.GoalReached())
+		},
+	)
+
+	t.Run(
+		"starts over when the review is resumed", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c)
+			review, err := review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+
+			c.now = now.Add(time.Hour)
+			resumed, err := flashcard.ResumeReview(review.Decks(), review.Session(), c, flashcard.WithGoal(flashcard.Goal{Cards: 1, Minutes: 10}))
+			require.NoError(t, err)
+
+			assert.Zero(t, resumed.GoalProgress())
+			assert.False(t, resumed.GoalReached())
+		},
+	)
+}
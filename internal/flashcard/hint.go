@@ -0,0 +1,68 @@
+package flashcard
+
+import (
+	"strings"
+)
+
+// ParseHints returns the hints written one per line, in the order they are revealed, the empty lines left out.
+func ParseHints(text string) []string {
+	var hints []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			hints = append(hints, line)
+		}
+	}
+	return hints
+}
+
+// SetHints replaces the hints of the card, which are its own and not shared with the other cards of the note.
+func (d Deck) SetHints(card Card, hints []string) (Deck, Card) {
+	card.Hints = ParseHints(strings.Join(hints, "\n"))
+	return d.Change(card), card
+}
+
+// HintedScore lowers the score to hard when hints were revealed before answering,
+// as the card was not fully recalled on its own.
+func HintedScore(score ReviewScore, hints int) ReviewScore {
+	if hints > 0 && score > ReviewScoreHard {
+		return ReviewScoreHard
+	}
+	return score
+}
+
+// HintedReviews returns how many of the reviews had hints revealed before answering.
+func HintedReviews(stats []Stats) int {
+	var count int
+	for _, s := range stats {
+		if s.Hints > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// RevealHint reveals the next hint of the current card, if it has any left.
+func (r Review) RevealHint() (Review, error) {
+	card, err := r.Card()
+	if err != nil {
+		return Review{}, err
+	}
+
+	r.hints = min(r.hints+1, len(card.Hints))
+	return r, nil
+}
+
+// Hints returns the hints of the current card revealed so far.
+func (r Review) Hints() []string {
+	card, err := r.Card()
+	if err != nil {
+		return nil
+	}
+	return card.Hints[:min(r.hints, len(card.Hints))]
+}
+
+// HasHints reports whether the current card has hints not revealed yet.
+func (r Review) HasHints() bool {
+	card, err := r.Card()
+	return err == nil && r.hints < len(card.Hints)
+}
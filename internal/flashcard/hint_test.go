@@ -0,0 +1,121 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestParseHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "\n ", want: nil},
+		{name: "one per line", text: "transport layer\n\n three way handshake ", want: []string{"transport layer", "three way handshake"}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, flashcard.ParseHints(tt.text))
+			},
+		)
+	}
+}
+
+func TestHintedScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		score flashcard.ReviewScore
+		hints int
+		want  flashcard.ReviewScore
+	}{
+		{name: "no hints", score: flashcard.ReviewScoreEasy, hints: 0, want: flashcard.ReviewScoreEasy},
+		{name: "easy with hints", score: flashcard.ReviewScoreEasy, hints: 1, want: flashcard.ReviewScoreHard},
+		{name: "good with hints", score: flashcard.ReviewScoreGood, hints: 2, want: flashcard.ReviewScoreHard},
+		{name: "again with hints", score: flashcard.ReviewScoreAgain, hints: 1, want: flashcard.ReviewScoreAgain},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, flashcard.HintedScore(tt.score, tt.hints))
+			},
+		)
+	}
+}
+
+func TestReview_RevealHint(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+	tcp := flashcard.NewCard("tcp", "transmission control protocol", now)
+	tcp.Hints = []string{"transport layer", "three way handshake"}
+	udp := flashcard.NewCard("udp", "user datagram protocol", now.Add(time.Second))
+	deck, err := flashcard.NewDeck("hints", c, []flashcard.Card{tcp, udp})
+	require.NoError(t, err)
+	// udp is due a second later, so tcp is always the first card.
+	review := flashcard.NewReview(deck, c, flashcard.WithOrder(flashcard.OrderDue))
+	current, err := review.Card()
+	require.NoError(t, err)
+	require.Equal(t, tcp.ID, current.ID)
+
+	t.Run(
+		"reveals the hints in order", func(t *testing.T) {
+			assert.True(t, review.HasHints())
+			assert.Empty(t, review.Hints())
+
+			revealed, err := review.RevealHint()
+			require.NoError(t, err)
+			assert.Equal(t, []string{"transport layer"}, revealed.Hints())
+
+			revealed, err = revealed.RevealHint()
+			require.NoError(t, err)
+			revealed, err = revealed.RevealHint()
+			require.NoError(t, err)
+			assert.Equal(t, tcp.Hints, revealed.Hints())
+			assert.False(t, revealed.HasHints())
+		},
+	)
+
+	t.Run(
+		"records the hints revealed in the stats", func(t *testing.T) {
+			revealed, err := review.RevealHint()
+			require.NoError(t, err)
+
+			rated, err := revealed.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+
+			stats := getCard(rated.Deck, tcp.ID).Stats
+			require.Len(t, stats, 1)
+			assert.Equal(t, 1, stats[len(stats)-1].Hints)
+			assert.Equal(t, 1, flashcard.HintedReviews(stats))
+			assert.Empty(t, rated.Hints())
+		},
+	)
+
+	t.Run(
+		"forgets the hints revealed when skipping", func(t *testing.T) {
+			revealed, err := review.RevealHint()
+			require.NoError(t, err)
+
+			skipped, err := revealed.Skip()
+			require.NoError(t, err)
+			skipped, err = skipped.Skip()
+			require.NoError(t, err)
+
+			assert.True(t, skipped.HasHints())
+			assert.Empty(t, skipped.Hints())
+		},
+	)
+}
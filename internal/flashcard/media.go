@@ -0,0 +1,137 @@
+package flashcard
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// MediaSuffix is appended to the deck file name, without the extension, to name the media directory of the deck.
+const MediaSuffix = ".media"
+
+// ErrInvalidMedia is returned when a media file name is outside the media directory of the deck.
+var ErrInvalidMedia = errors.New("invalid media file")
+
+// imagePattern matches the Markdown image references, like ![diagram](handshake.png "title").
+var imagePattern = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)((?:\s+"[^"]*")?)\)`)
+
+// MediaDir returns the directory with the media files of the deck, next to the deck file.
+// It is empty when the deck was not saved yet.
+func (d Deck) MediaDir() string {
+	return d.mediaDir
+}
+
+// mediaName returns the name of the file in the media directory referenced by the target,
+// or false when the target is an URL, an absolute path or is outside the media directory.
+func mediaName(target string) (string, bool) {
+	if strings.Contains(target, ":") || path.IsAbs(target) || filepath.IsAbs(target) {
+		return "", false
+	}
+
+	name := path.Clean(target)
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return "", false
+	}
+	return name, true
+}
+
+// Media returns the names of the media files referenced by the cards, relative to the media directory and sorted.
+func (d Deck) Media() []string {
+	var names []string
+	for _, card := range d.Cards {
+		texts := []string{card.Question, card.Answer}
+		for _, value := range card.Fields {
+			texts = append(texts, value)
+		}
+
+		for _, text := range texts {
+			for _, match := range imagePattern.FindAllStringSubmatch(text, -1) {
+				if name, ok := mediaName(match[2]); ok && !slices.Contains(names, name) {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ResolveMedia rewrites the images of the text referencing the media files to their path in the media directory.
+func (d Deck) ResolveMedia(text string) string {
+	if d.mediaDir == "" {
+		return text
+	}
+
+	return imagePattern.ReplaceAllStringFunc(
+		text, func(image string) string {
+			match := imagePattern.FindStringSubmatch(image)
+			name, ok := mediaName(match[2])
+			if !ok {
+				return image
+			}
+			return "![" + match[1] + "](" + filepath.ToSlash(filepath.Join(d.mediaDir, filepath.FromSlash(name))) + match[3] + ")"
+		},
+	)
+}
+
+// Images returns the targets of the images referenced by the text, in order.
+func Images(text string) []string {
+	var targets []string
+	for _, match := range imagePattern.FindAllStringSubmatch(text, -1) {
+		targets = append(targets, match[2])
+	}
+	return targets
+}
+
+// OrphanMedia returns the files of the media directory of the deck not referenced by any card, sorted.
+func (r *Repository) OrphanMedia(deck Deck) ([]string, error) {
+	dir := mediaDirpath(r.path, deck.ID)
+	used := deck.Media()
+
+	var orphans []string
+	err := filepath.WalkDir(
+		dir, func(file string, entry fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) && file == dir {
+				return fs.SkipDir
+			}
+			if err != nil || entry.IsDir() {
+				return err
+			}
+
+			name, err := filepath.Rel(dir, file)
+			if err != nil {
+				return err
+			}
+			if name = filepath.ToSlash(name); !slices.Contains(used, name) {
+				orphans = append(orphans, name)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("read media of deck '%s': %w", deck.ID, err)
+	}
+
+	return orphans, nil
+}
+
+// RemoveMedia deletes the files from the media directory of the deck.
+func (r *Repository) RemoveMedia(deck Deck, names []string) error {
+	dir := mediaDirpath(r.path, deck.ID)
+	for _, name := range names {
+		clean, ok := mediaName(name)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrInvalidMedia, name)
+		}
+		if err := os.Remove(filepath.Join(dir, filepath.FromSlash(clean))); err != nil {
+			return fmt.Errorf("delete media '%s': %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package flashcard_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eliostvs/lembrol/internal/clock"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+	"github.com/eliostvs/lembrol/internal/test"
+)
+
+const mediaDeckPath = "./testdata/media"
+
+func TestDeck_Media(t *testing.T) {
+	t.Parallel()
+
+	deck, err := flashcard.NewDeck("media", clock.New(), []flashcard.Card{
+		flashcard.NewCard("![a](b/diagram.png) ![c](/etc/passwd)", `![d](../secret.png) ![e](https://example.com/e.png "E")`, time.Now()),
+		flashcard.NewCard("![a](./handshake.png)", "![b](b/diagram.png)", time.Now()),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b/diagram.png", "handshake.png"}, deck.Media())
+}
+
+func TestDeck_ResolveMedia(t *testing.T) {
+	t.Parallel()
+
+	location := test.TempCopyDir(t, mediaDeckPath)
+	deck, err := newTestRepository(t, location, clock.New()).Find("Diagrams")
+	require.NoError(t, err)
+
+	text := deck.ResolveMedia(`![TCP](handshake.png "TCP") and ![remote](https://example.com/logo.png)`)
+
+	media := filepath.ToSlash(filepath.Join(location, "diagrams"+flashcard.MediaSuffix))
+	assert.Equal(t, media, filepath.ToSlash(deck.MediaDir()))
+	assert.Equal(t, `![TCP](`+media+`/handshake.png "TCP") and ![remote](https://example.com/logo.png)`, text)
+	assert.Equal(t, []string{media + "/handshake.png", "https://example.com/logo.png"}, flashcard.Images(text))
+}
+
+func TestRepository_OrphanMedia(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"returns the files not referenced by the cards", func(t *testing.T) {
+			repo := newTestRepository(t, test.TempCopyDir(t, mediaDeckPath), clock.New())
+			deck, err := repo.Find("Diagrams")
+			require.NoError(t, err)
+
+			orphans, err := repo.OrphanMedia(deck)
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"orphan.png"}, orphans)
+		},
+	)
+
+	t.Run(
+		"returns nothing when the deck has no media directory", func(t *testing.T) {
+			repo := newTestRepository(t, t.TempDir(), clock.New())
+			deck, err := repo.Create("Empty", nil)
+			require.NoError(t, err)
+
+			orphans, err := repo.OrphanMedia(deck)
+
+			require.NoError(t, err)
+			assert.Empty(t, orphans)
+		},
+	)
+}
+
+func TestRepository_RemoveMedia(t *testing.T) {
+	t.Parallel()
+
+	location := test.TempCopyDir(t, mediaDeckPath)
+	repo := newTestRepository(t, location, clock.New())
+	deck, err := repo.Find("Diagrams")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.RemoveMedia(deck, []string{"orphan.png"}))
+
+	_, err = os.Stat(filepath.Join(deck.MediaDir(), "orphan.png"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.ErrorIs(t, repo.RemoveMedia(deck, []string{"../diagrams.json"}), flashcard.ErrInvalidMedia)
+}
@@ -0,0 +1,207 @@
+package flashcard
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	nanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// BasicNoteType is the name of the built-in note type, the one of the cards with a question and an answer.
+const BasicNoteType = "Basic"
+
+var (
+	// ErrUnknownNoteType is returned when a note uses a type not defined in the deck.
+	ErrUnknownNoteType = errors.New("unknown note type")
+	// ErrEmptyNote is returned when no template of the note type renders a question.
+	ErrEmptyNote = errors.New("empty note")
+)
+
+// Basic returns the built-in note type, with a question and an answer rendered as they are.
+func Basic() NoteType {
+	return NoteType{
+		Name:   BasicNoteType,
+		Fields: []string{"Question", "Answer"},
+		Templates: []Template{
+			{Name: "Card 1", Front: "{{.Question}}", Back: "{{.Answer}}"},
+		},
+	}
+}
+
+// NoteType defines the named fields of a note and the templates that render its cards.
+type NoteType struct {
+	Name   string   `json:"name" validate:"required"`
+	Fields []string `json:"fields" validate:"min=1,dive,required"`
+	// Templates render one card each from the note fields, using the Go text/template syntax.
+	Templates []Template `json:"templates" validate:"min=1,dive"`
+}
+
+// Template is the pair of Go text/templates that renders the question and the answer of a card.
+type Template struct {
+	Name  string `json:"name" validate:"required"`
+	Front string `json:"front" validate:"required"`
+	Back  string `json:"back" validate:"required"`
+}
+
+func (t Template) render(name, text string, fields map[string]string) (string, error) {
+	tmpl, err := template.New(t.Name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s template '%s': %w", name, t.Name, err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, fields); err != nil {
+		return "", fmt.Errorf("render %s template '%s': %w", name, t.Name, err)
+	}
+	return b.String(), nil
+}
+
+// Render returns the question and the answer of the card rendered with the fields.
+func (t Template) Render(fields map[string]string) (question, answer string, err error) {
+	if question, err = t.render("front", t.Front, fields); err != nil {
+		return "", "", err
+	}
+	if answer, err = t.render("back", t.Back, fields); err != nil {
+		return "", "", err
+	}
+	return question, answer, nil
+}
+
+// NoteType returns the name of the note type of the card, Basic for the cards created without one.
+func (c Card) NoteType() string {
+	if c.Type == "" {
+		return BasicNoteType
+	}
+	return c.Type
+}
+
+// Values returns the fields of the note of the card.
+// Cards created before the note types are read as Basic notes.
+func (c Card) Values() map[string]string {
+	if c.Type == "" {
+		return map[string]string{"Question": c.Question, "Answer": c.Answer}
+	}
+	return c.Fields
+}
+
+// Types returns the note types available in the deck, the built-in Basic first.
+func (d Deck) Types() []NoteType {
+	types := []NoteType{Basic()}
+	for _, t := range d.NoteTypes {
+		if t.Name != BasicNoteType {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// NoteType returns the note type with the given name.
+func (d Deck) NoteType(name string) (NoteType, error) {
+	for _, t := range d.Types() {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return NoteType{}, fmt.Errorf("%w: %s", ErrUnknownNoteType, name)
+}
+
+// renderNote renders a card for each template of the note type, leaving out the ones with an empty question.
+func renderNote(noteType NoteType, fields map[string]string) (map[int][2]string, error) {
+	sides := make(map[int][2]string)
+	for i, t := range noteType.Templates {
+		question, answer, err := t.Render(fields)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(question) != "" {
+			sides[i] = [2]string{question, answer}
+		}
+	}
+
+	if len(sides) == 0 {
+		return nil, ErrEmptyNote
+	}
+	return sides, nil
+}
+
+// AddNote adds the cards rendered by the templates of the note type from the fields, sharing the same note.
+func (d Deck) AddNote(typeName string, fields map[string]string) (Deck, []Card, error) {
+	noteType, err := d.NoteType(typeName)
+	if err != nil {
+		return d, nil, err
+	}
+
+	sides, err := renderNote(noteType, fields)
+	if err != nil {
+		return d, nil, err
+	}
+
+	note := nanoid.Must()
+	cards := make([]Card, 0, len(sides))
+	for i := range noteType.Templates {
+		side, ok := sides[i]
+		if !ok {
+			continue
+		}
+		card := NewCard(side[0], side[1], d.clock.Now())
+		if noteType.Name != BasicNoteType {
+			card.Note, card.Type, card.Fields, card.Template = note, noteType.Name, fields, i
+		}
+		cards = append(cards, card)
+	}
+
+	d.Cards = append(slices.Clip(d.Cards), cards...)
+	return d, cards, nil
+}
+
+// ChangeNote rewrites the fields of the note of the card and renders its cards again, keeping the
+// schedule of the ones still rendered, adding the new ones and removing the ones left empty.
+// It returns the cards of the note after the change.
+func (d Deck) ChangeNote(card Card, fields map[string]string) (Deck, []Card, error) {
+	noteType, err := d.NoteType(card.NoteType())
+	if err != nil {
+		return d, nil, err
+	}
+
+	sides, err := renderNote(noteType, fields)
+	if err != nil {
+		return d, nil, err
+	}
+
+	existing := make(map[int]bool)
+	cards := make([]Card, 0, len(d.Cards))
+	var changed []Card
+	for _, c := range d.Cards {
+		isSibling := c.ID == card.ID || card.Type != "" && c.Note == card.Note
+		side, ok := sides[c.Template]
+		switch {
+		case !isSibling:
+			cards = append(cards, c)
+		case ok && !existing[c.Template]:
+			existing[c.Template] = true
+			c = rewrite(c, side[0], side[1])
+			if c.Type != "" {
+				c.Fields = fields
+			}
+			cards = append(cards, c)
+			changed = append(changed, c)
+		}
+	}
+
+	for i := range noteType.Templates {
+		side, ok := sides[i]
+		if !ok || existing[i] {
+			continue
+		}
+		c := NewCard(side[0], side[1], d.clock.Now())
+		c.Note, c.Type, c.Fields, c.Template = card.Note, noteType.Name, fields, i
+		cards = append(cards, c)
+		changed = append(changed, c)
+	}
+
+	d.Cards = cards
+	return d, changed, nil
+}
@@ -0,0 +1,202 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+var wordNoteType = flashcard.NoteType{
+	Name:   "Word",
+	Fields: []string{"Word", "Meaning", "Example"},
+	Templates: []flashcard.Template{
+		{Name: "Recognition", Front: "{{.Word}}", Back: "{{.Meaning}}{{if .Example}} ({{.Example}}){{end}}"},
+		{Name: "Example", Front: "{{.Example}}", Back: "{{.Word}}"},
+	},
+}
+
+func newNoteDeck(t *testing.T, now time.Time) flashcard.Deck {
+	t.Helper()
+
+	deck, err := flashcard.NewDeck("notes", testclock.New(now), []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+	require.NoError(t, err)
+	deck.NoteTypes = []flashcard.NoteType{wordNoteType}
+	return deck
+}
+
+func TestDeck_NoteType(t *testing.T) {
+	t.Parallel()
+
+	deck := newNoteDeck(t, time.Now())
+
+	assert.Equal(t, []flashcard.NoteType{flashcard.Basic(), wordNoteType}, deck.Types())
+
+	noteType, err := deck.NoteType("Word")
+	require.NoError(t, err)
+	assert.Equal(t, wordNoteType, noteType)
+
+	_, err = deck.NoteType("Unknown")
+	assert.ErrorIs(t, err, flashcard.ErrUnknownNoteType)
+}
+
+func TestCard_Values(t *testing.T) {
+	t.Parallel()
+
+	card := flashcard.NewCard("question", "answer", time.Now())
+
+	assert.Equal(t, flashcard.BasicNoteType, card.NoteType())
+	assert.Equal(t, map[string]string{"Question": "question", "Answer": "answer"}, card.Values())
+}
+
+func TestDeck_AddNote(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck := newNoteDeck(t, now)
+
+	t.Run(
+		"adds a card for each template", func(t *testing.T) {
+			fields := map[string]string{"Word": "dog", "Meaning": "cachorro", "Example": "the dog barks"}
+
+			deck, cards, err := deck.AddNote("Word", fields)
+			require.NoError(t, err)
+
+			require.Len(t, cards, 2)
+			assert.Equal(t, 3, deck.Total())
+			assert.Equal(t, "dog", cards[0].Question)
+			assert.Equal(t, "cachorro (the dog barks)", cards[0].Answer)
+			assert.Equal(t, "the dog barks", cards[1].Question)
+			assert.Equal(t, "dog", cards[1].Answer)
+			for i, card := range cards {
+				assert.Equal(t, "Word", card.Type)
+				assert.Equal(t, i, card.Template)
+				assert.Equal(t, fields, card.Values())
+				assert.Equal(t, cards[0].Note, card.Note)
+			}
+		},
+	)
+
+	t.Run(
+		"leaves out the templates with an empty question", func(t *testing.T) {
+			_, cards, err := deck.AddNote("Word", map[string]string{"Word": "dog", "Meaning": "cachorro"})
+			require.NoError(t, err)
+
+			require.Len(t, cards, 1)
+			assert.Equal(t, "cachorro", cards[0].Answer)
+		},
+	)
+
+	t.Run(
+		"adds a plain card for the basic type", func(t *testing.T) {
+			_, cards, err := deck.AddNote(flashcard.BasicNoteType, map[string]string{"Question": "q", "Answer": "a"})
+			require.NoError(t, err)
+
+			require.Len(t, cards, 1)
+			assert.Equal(t, "q", cards[0].Question)
+			assert.Equal(t, "a", cards[0].Answer)
+			assert.Empty(t, cards[0].Type)
+			assert.Empty(t, cards[0].Note)
+		},
+	)
+
+	t.Run(
+		"returns error when", func(t *testing.T) {
+			tests := []struct {
+				name     string
+				noteType string
+				fields   map[string]string
+				err      error
+			}{
+				{name: "the type is unknown", noteType: "Unknown", err: flashcard.ErrUnknownNoteType},
+				{name: "all questions are empty", noteType: "Word", fields: map[string]string{"Meaning": "cachorro"}, err: flashcard.ErrEmptyNote},
+			}
+			for _, tt := range tests {
+				t.Run(
+					tt.name, func(t *testing.T) {
+						_, _, err := deck.AddNote(tt.noteType, tt.fields)
+
+						assert.ErrorIs(t, err, tt.err)
+					},
+				)
+			}
+		},
+	)
+
+	t.Run(
+		"returns error when the template is invalid", func(t *testing.T) {
+			deck := newNoteDeck(t, now)
+			deck.NoteTypes = []flashcard.NoteType{
+				{Name: "Broken", Fields: []string{"Word"}, Templates: []flashcard.Template{{Name: "Card", Front: "{{.Word", Back: "{{.Word}}"}}},
+			}
+
+			_, _, err := deck.AddNote("Broken", map[string]string{"Word": "dog"})
+
+			assert.ErrorContains(t, err, "parse front template 'Card'")
+		},
+	)
+}
+
+func TestDeck_ChangeNote(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+
+	newStudiedNoteDeck := func(t *testing.T) (flashcard.Deck, []flashcard.Card) {
+		t.Helper()
+
+		deck, cards, err := newNoteDeck(t, now).AddNote("Word", map[string]string{"Word": "dog", "Meaning": "cachorro"})
+		require.NoError(t, err)
+
+		// the note was studied, so it has a schedule to keep.
+		review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Query: "is:new"}))
+		for review.Left() > 0 {
+			review, err = review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+		}
+		return review.Deck, cards
+	}
+
+	t.Run(
+		"renders the cards again keeping their schedule", func(t *testing.T) {
+			deck, cards := newStudiedNoteDeck(t)
+			before := getCard(deck, cards[0].ID)
+			require.Equal(t, fsrs.Review, before.State)
+
+			fields := map[string]string{"Word": "dogs", "Meaning": "cachorros"}
+			deck, changed, err := deck.ChangeNote(before, fields)
+			require.NoError(t, err)
+
+			require.Len(t, changed, 1)
+			after := getCard(deck, cards[0].ID)
+			assert.Equal(t, "dogs", after.Question)
+			assert.Equal(t, "cachorros", after.Answer)
+			assert.Equal(t, fields, after.Fields)
+			assert.Equal(t, before.Stability, after.Stability)
+			assert.Equal(t, before.Due, after.Due)
+			assert.Equal(t, 2, deck.Total())
+		},
+	)
+
+	t.Run(
+		"adds and removes the cards of the templates changed", func(t *testing.T) {
+			deck, cards := newStudiedNoteDeck(t)
+
+			deck, changed, err := deck.ChangeNote(cards[0], map[string]string{"Meaning": "cachorro", "Example": "the dog barks"})
+			require.NoError(t, err)
+
+			require.Len(t, changed, 1)
+			assert.Equal(t, 1, changed[0].Template)
+			assert.Equal(t, fsrs.New, changed[0].State)
+			assert.Equal(t, cards[0].Note, changed[0].Note)
+			assert.Empty(t, getCard(deck, cards[0].ID).ID)
+			assert.Equal(t, 2, deck.Total())
+		},
+	)
+}
@@ -0,0 +1,135 @@
+package flashcard
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+)
+
+// ReviewOrder defines the order the cards are shown in a review session.
+type ReviewOrder string
+
+const (
+	// OrderRandom shuffles the cards.
+	OrderRandom ReviewOrder = "random"
+	// OrderDue shows the cards due for longer first.
+	OrderDue ReviewOrder = "due"
+	// OrderRetrievability shows the cards most likely to be forgotten first.
+	OrderRetrievability ReviewOrder = "retrievability"
+	// OrderDifficulty shows the most difficult cards first.
+	OrderDifficulty ReviewOrder = "difficulty"
+	// OrderNewFirst shows the new cards before the others.
+	OrderNewFirst ReviewOrder = "new_first"
+	// OrderNewLast shows the new cards after the others.
+	OrderNewLast ReviewOrder = "new_last"
+	// OrderInterleaved spreads the new cards evenly among the others.
+	OrderInterleaved ReviewOrder = "interleaved"
+)
+
+// ReviewOrders lists all the review orders.
+var ReviewOrders = []ReviewOrder{
+	OrderRandom,
+	OrderDue,
+	OrderRetrievability,
+	OrderDifficulty,
+	OrderNewFirst,
+	OrderNewLast,
+	OrderInterleaved,
+}
+
+// ParseReviewOrder converts a string to a ReviewOrder, an empty string is the random order.
+func ParseReviewOrder(s string) (ReviewOrder, error) {
+	if s == "" {
+		return OrderRandom, nil
+	}
+
+	order := ReviewOrder(s)
+	if !slices.Contains(ReviewOrders, order) {
+		return "", fmt.Errorf("invalid order %q", s)
+	}
+	return order, nil
+}
+
+// sortQueue orders the queue in place. The cards are shuffled first,
+// so the cards that compare equal still come in a random order.
+func sortQueue(queue []reviewCard, order ReviewOrder, random *rand.Rand, scheduler *Scheduler, now time.Time) {
+	random.Shuffle(len(queue), func(i, j int) { queue[i], queue[j] = queue[j], queue[i] })
+
+	isNew := func(c reviewCard) bool { return c.State == fsrs.New }
+
+	switch order {
+	case OrderDue:
+		slices.SortStableFunc(queue, func(a, b reviewCard) int {
+			return a.Due.Compare(b.Due)
+		})
+
+	case OrderRetrievability:
+		// New cards have nothing to forget, so they go last.
+		retrievability := func(c reviewCard) float64 {
+			if isNew(c) {
+				return 2
+			}
+			return scheduler.GetRetrievability(c.Card, now)
+		}
+		slices.SortStableFunc(queue, func(a, b reviewCard) int {
+			return cmp.Compare(retrievability(a), retrievability(b))
+		})
+
+	case OrderDifficulty:
+		slices.SortStableFunc(queue, func(a, b reviewCard) int {
+			return cmp.Compare(b.Difficulty, a.Difficulty)
+		})
+
+	case OrderNewFirst:
+		slices.SortStableFunc(queue, func(a, b reviewCard) int {
+			return compareBool(isNew(b), isNew(a))
+		})
+
+	case OrderNewLast:
+		slices.SortStableFunc(queue, func(a, b reviewCard) int {
+			return compareBool(isNew(a), isNew(b))
+		})
+
+	case OrderInterleaved:
+		interleave(queue, isNew)
+	}
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// interleave spreads the items matching fn evenly among the others, keeping the relative order of both.
+func interleave[T any](items []T, fn func(T) bool) {
+	var matched, others []T
+	for _, item := range items {
+		if fn(item) {
+			matched = append(matched, item)
+		} else {
+			others = append(others, item)
+		}
+	}
+
+	var m, o int
+	for i := range items {
+		// Take a matched item while the share of matched items taken is behind the share of others.
+		if m < len(matched) && (o == len(others) || m*len(others) <= o*len(matched)) {
+			items[i] = matched[m]
+			m++
+		} else {
+			items[i] = others[o]
+			o++
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestParseReviewOrder(t *testing.T) {
+	t.Parallel()
+
+	order, err := flashcard.ParseReviewOrder("")
+	require.NoError(t, err)
+	assert.Equal(t, flashcard.OrderRandom, order)
+
+	order, err = flashcard.ParseReviewOrder("retrievability")
+	require.NoError(t, err)
+	assert.Equal(t, flashcard.OrderRetrievability, order)
+
+	_, err = flashcard.ParseReviewOrder("alphabetical")
+	assert.EqualError(t, err, `invalid order "alphabetical"`)
+}
+
+func TestReview_WithOrder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+
+	newCard := func(question string) flashcard.Card {
+		return flashcard.NewCard(question, "answer", now)
+	}
+	reviewCard := func(question string, stability, difficulty float64, lastReview, due time.Duration) flashcard.Card {
+		card := newReviewCard(question, stability, now.Add(-lastReview), now.Add(-due))
+		card.Difficulty = difficulty
+		return card
+	}
+
+	day := 24 * time.Hour
+	deck, err := flashcard.NewDeck(
+		"order", c, []flashcard.Card{
+			newCard("New 1"),
+			reviewCard("Recent", 100, 3, 2*day, time.Hour),
+			newCard("New 2"),
+			reviewCard("Old", 5, 8, 20*day, 10*day),
+			reviewCard("Middle", 10, 5, 12*day, 2*day),
+		},
+	)
+	require.NoError(t, err)
+
+	// questions returns the questions in the order of the review queue.
+	questions := func(review flashcard.Review) []string {
+		var result []string
+		for range review.Left() {
+			card, err := review.Card()
+			require.NoError(t, err)
+			result = append(result, card.Question)
+			review, err = review.Skip()
+			require.NoError(t, err)
+		}
+		return result
+	}
+
+	isNew := func(question string) bool {
+		return question == "New 1" || question == "New 2"
+	}
+
+	tests := []struct {
+		order flashcard.ReviewOrder
+		want  func(t *testing.T, got []string)
+	}{
+		{
+			order: flashcard.OrderDue,
+			want: func(t *testing.T, got []string) {
+				assert.Equal(t, []string{"Old", "Middle", "Recent"}, got[:3])
+			},
+		},
+		{
+			order: flashcard.OrderRetrievability,
+			want: func(t *testing.T, got []string) {
+				assert.Equal(t, []string{"Old", "Middle", "Recent"}, got[:3])
+				assert.ElementsMatch(t, []string{"New 1", "New 2"}, got[3:])
+			},
+		},
+		{
+			order: flashcard.OrderDifficulty,
+			want: func(t *testing.T, got []string) {
+				assert.Equal(t, []string{"Old", "Middle", "Recent"}, got[:3])
+			},
+		},
+		{
+			order: flashcard.OrderNewFirst,
+			want: func(t *testing.T, got []string) {
+				assert.ElementsMatch(t, []string{"New 1", "New 2"}, got[:2])
+			},
+		},
+		{
+			order: flashcard.OrderNewLast,
+			want: func(t *testing.T, got []string) {
+				assert.ElementsMatch(t, []string{"New 1", "New 2"}, got[3:])
+			},
+		},
+		{
+			order: flashcard.OrderInterleaved,
+			want: func(t *testing.T, got []string) {
+				pattern := make([]bool, 0, len(got))
+				for _, question := range got {
+					pattern = append(pattern, isNew(question))
+				}
+				assert.Equal(t, []bool{true, false, false, true, false}, pattern)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(
+			string(tt.order), func(t *testing.T) {
+				t.Parallel()
+
+				got := questions(flashcard.NewReview(deck, c, flashcard.WithOrder(tt.order)))
+
+				assert.Len(t, got, 5)
+				tt.want(t, got)
+			},
+		)
+	}
+
+	t.Run(
+		"uses the order of the deck settings", func(t *testing.T) {
+			t.Parallel()
+
+			deck := deck
+			deck.Settings.ReviewOrder = flashcard.OrderDue
+
+			assert.Equal(t, "Old", questions(flashcard.NewReview(deck, c))[0])
+		},
+	)
+
+	t.Run(
+		"uses the order of the filter", func(t *testing.T) {
+			t.Parallel()
+
+			filter := flashcard.Filter{Query: "is:review", Order: flashcard.OrderDue}
+
+			assert.Equal(t, []string{"Old", "Middle", "Recent"}, questions(flashcard.NewReview(deck, c, flashcard.WithFilter(filter))))
+		},
+	)
+
+	t.Run(
+		"shuffles the same way with the same seed", func(t *testing.T) {
+			t.Parallel()
+
+			first := questions(flashcard.NewReview(deck, c, flashcard.WithSeed(42)))
+			second := questions(flashcard.NewReview(deck, c, flashcard.WithSeed(42)))
+
+			assert.Equal(t, first, second)
+		},
+	)
+}
@@ -0,0 +1,200 @@
+package flashcard
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+)
+
+// ErrInvalidQuery is returned by ParseQuery when the query has an unknown or malformed term.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Query selects cards using space separated terms that must all match.
+// A term prefixed with "-" must not match. The supported terms are:
+//
+//	is:new, is:learning, is:review, is:due, is:suspended, is:buried, is:leech, is:flagged
+//	failed:N      answered again in the last N study days
+//	rated:N       reviewed in the last N study days
+//	unreviewed:N  not reviewed in the last N days
+//	tag:name      tagged with name
+//	flag:colour   flagged with the colour, like flag:red
+//	word          question or answer containing word
+//
+// Suspended cards only match when the query asks for them with is:suspended.
+type Query struct {
+	raw              string
+	terms            []term
+	includeSuspended bool
+}
+
+type term struct {
+	negate bool
+	match  func(card Card, now time.Time, settings Settings) bool
+}
+
+// ParseQuery parses the query terms.
+func ParseQuery(s string) (Query, error) {
+	query := Query{raw: strings.TrimSpace(s)}
+
+	for _, field := range strings.Fields(s) {
+		negate := strings.HasPrefix(field, "-") && len(field) > 1
+		if negate {
+			field = field[1:]
+		}
+
+		match, err := parseTerm(field)
+		if err != nil {
+			return Query{}, err
+		}
+
+		if !negate && strings.EqualFold(field, "is:suspended") {
+			query.includeSuspended = true
+		}
+		query.terms = append(query.terms, term{negate: negate, match: match})
+	}
+
+	return query, nil
+}
+
+func parseTerm(field string) (func(Card, time.Time, Settings) bool, error) {
+	name, value, found := strings.Cut(field, ":")
+	if !found {
+		word := strings.ToLower(field)
+		return func(card Card, _ time.Time, _ Settings) bool {
+			return strings.Contains(strings.ToLower(card.Question), word) ||
+				strings.Contains(strings.ToLower(card.Answer), word)
+		}, nil
+	}
+
+	switch strings.ToLower(name) {
+	case "is":
+		return parseState(value)
+
+	case "tag":
+		return func(card Card, _ time.Time, _ Settings) bool {
+			return card.HasTag(value)
+		}, nil
+
+	case "flag":
+		flag, err := ParseFlag(value)
+		if err != nil || flag == FlagNone {
+			return nil, fmt.Errorf("%w: unknown flag %q", ErrInvalidQuery, value)
+		}
+		return func(card Card, _ time.Time, _ Settings) bool {
+			return card.Flag == flag
+		}, nil
+
+	case "failed":
+		days, err := parseDays(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return func(card Card, now time.Time, settings Settings) bool {
+			return slices.ContainsFunc(card.Stats, func(stats Stats) bool {
+				return stats.Rating == fsrs.Again && withinStudyDays(stats.LastReview, now, days, settings)
+			})
+		}, nil
+
+	case "rated":
+		days, err := parseDays(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return func(card Card, now time.Time, settings Settings) bool {
+			return slices.ContainsFunc(card.Stats, func(stats Stats) bool {
+				return withinStudyDays(stats.LastReview, now, days, settings)
+			})
+		}, nil
+
+	case "unreviewed":
+		days, err := parseDays(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return func(card Card, now time.Time, _ Settings) bool {
+			return card.LastReview.Before(now.AddDate(0, 0, -days))
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown term %q", ErrInvalidQuery, field)
+}
+
+func parseState(value string) (func(Card, time.Time, Settings) bool, error) {
+	switch strings.ToLower(value) {
+	case "new":
+		return hasState(fsrs.New), nil
+	case "learning":
+		return hasState(fsrs.Learning, fsrs.Relearning), nil
+	case "review":
+		return hasState(fsrs.Review), nil
+	case "due":
+		return func(card Card, now time.Time, settings Settings) bool {
+			return card.IsDue(now, settings) && !card.IsBuried(now)
+		}, nil
+	case "suspended":
+		return func(card Card, _ time.Time, _ Settings) bool { return card.Suspended }, nil
+	case "buried":
+		return func(card Card, now time.Time, _ Settings) bool { return card.IsBuried(now) }, nil
+	case "leech":
+		return func(card Card, _ time.Time, _ Settings) bool { return card.Leech }, nil
+	case "flagged":
+		return func(card Card, _ time.Time, _ Settings) bool { return card.IsFlagged() }, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown state %q", ErrInvalidQuery, value)
+}
+
+func hasState(states ...fsrs.State) func(Card, time.Time, Settings) bool {
+	return func(card Card, _ time.Time, _ Settings) bool {
+		return slices.Contains(states, card.State)
+	}
+}
+
+func parseDays(field, value string) (int, error) {
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 1 {
+		return 0, fmt.Errorf("%w: %q needs a number of days greater than zero", ErrInvalidQuery, field)
+	}
+	return days, nil
+}
+
+// withinStudyDays reports whether t belongs to one of the last days study days, today included.
+func withinStudyDays(t, now time.Time, days int, settings Settings) bool {
+	return !settings.StudyDay(t).Before(settings.StudyDay(now).AddDate(0, 0, 1-days))
+}
+
+// Match reports whether the card matches all the query terms at the instant now.
+func (q Query) Match(card Card, now time.Time, settings Settings) bool {
+	if card.Suspended && !q.includeSuspended {
+		return false
+	}
+
+	for _, t := range q.terms {
+		if t.match(card, now, settings) == t.negate {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (q Query) String() string {
+	return q.raw
+}
+
+// Filter is a saved query used to study cards regardless of their due dates.
+type Filter struct {
+	Name  string `json:"name" validate:"required"`
+	Query string `json:"query"`
+	// Limit is the maximum number of cards in the session, zero means no limit.
+	Limit int `json:"limit,omitempty" validate:"gte=0"`
+	// Cram sessions do not change the schedule of the cards.
+	Cram bool `json:"cram,omitempty"`
+	// Order overrides the review order of the deck settings.
+	Order ReviewOrder `json:"order,omitempty" validate:"omitempty,oneof=random due retrievability difficulty new_first new_last interleaved"`
+}
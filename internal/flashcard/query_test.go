@@ -0,0 +1,101 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		err   bool
+	}{
+		{name: "empty", query: ""},
+		{name: "words and terms", query: "golang is:new -is:leech tag:go failed:1 rated:7 unreviewed:90"},
+		{name: "unknown term", query: "foo:bar", err: true},
+		{name: "unknown state", query: "is:whatever", err: true},
+		{name: "flag", query: "is:flagged -flag:red"},
+		{name: "unknown flag", query: "flag:pink", err: true},
+		{name: "days are not a number", query: "failed:today", err: true},
+		{name: "days are not positive", query: "unreviewed:0", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := flashcard.ParseQuery(tt.query)
+
+			if tt.err {
+				assert.ErrorIs(t, err, flashcard.ErrInvalidQuery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.query, query.String())
+			}
+		})
+	}
+}
+
+func TestQuery_Match(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	settings := flashcard.Settings{DayStartsAt: 4}
+
+	unseen := flashcard.NewCard("What is a goroutine?", "A lightweight thread", now.AddDate(0, 0, -100))
+	unseen.Tags = []string{"Concurrency"}
+
+	failed := newReviewCard("What is a channel?", 10, now.Add(-time.Hour), now.AddDate(0, 0, 5))
+	failed.Stats = []flashcard.Stats{{Rating: fsrs.Again, LastReview: now.Add(-time.Hour)}}
+
+	yesterday := newReviewCard("What is a slice?", 10, now.AddDate(0, 0, -1), now.AddDate(0, 0, 5))
+	yesterday.Stats = []flashcard.Stats{{Rating: fsrs.Again, LastReview: now.AddDate(0, 0, -1)}}
+	yesterday.Flag = flashcard.FlagRed
+
+	suspended := newReviewCard("What is a map?", 10, now.AddDate(0, 0, -1), now)
+	suspended.Suspended = true
+
+	cards := []flashcard.Card{unseen, failed, yesterday, suspended}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "", want: []string{unseen.Question, failed.Question, yesterday.Question}},
+		{query: "is:new", want: []string{unseen.Question}},
+		{query: "-is:new", want: []string{failed.Question, yesterday.Question}},
+		{query: "failed:1", want: []string{failed.Question}},
+		{query: "failed:2", want: []string{failed.Question, yesterday.Question}},
+		{query: "rated:1", want: []string{failed.Question}},
+		{query: "unreviewed:90", want: []string{unseen.Question}},
+		{query: "tag:concurrency", want: []string{unseen.Question}},
+		{query: "THREAD", want: []string{unseen.Question}},
+		{query: "is:due", want: []string{unseen.Question}},
+		{query: "is:suspended", want: []string{suspended.Question}},
+		{query: "is:flagged", want: []string{yesterday.Question}},
+		{query: "flag:red", want: []string{yesterday.Question}},
+		{query: "flag:blue", want: nil},
+		{query: "what is:review", want: []string{failed.Question, yesterday.Question}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := flashcard.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			var got []string
+			for _, card := range cards {
+				if query.Match(card, now, settings) {
+					got = append(got, card.Question)
+				}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -65,13 +65,14 @@ func openDeck(filename string, clock clock.Clock) (Deck, error) {
 		return Deck{}, fmt.Errorf("read deck file '%s' : %w", filename, err)
 	}
 
-	var deck Deck
+	deck := Deck{Settings: DefaultSettings()}
 	if err := json.Unmarshal(data, &deck); err != nil {
 		return Deck{}, fmt.Errorf("unmarshall deck '%s' : %w", filename, err)
 	}
 
 	deck.ID = filepathBaseWithoutExt(filename)
 	deck.clock = clock
+	deck.mediaDir = mediaDirpath(filepath.Dir(filename), deck.ID)
 
 	return deck, nil
 }
@@ -120,6 +121,7 @@ func (r *Repository) Create(name string, cards []Card) (Deck, error) {
 		return Deck{}, fmt.Errorf("deck '%s' already exists", deck.Name)
 	}
 
+	deck.mediaDir = mediaDirpath(r.path, deck.ID)
 	if err := r.Save(deck); err != nil {
 		return Deck{}, err
 	}
@@ -159,6 +161,10 @@ func (r *Repository) Delete(deck Deck) error {
 		return fmt.Errorf("delete deck '%s': %w", deck.ID, err)
 	}
 
+	if err := os.RemoveAll(mediaDirpath(r.path, deck.ID)); err != nil {
+		return fmt.Errorf("delete media of deck '%s': %w", deck.ID, err)
+	}
+
 	delete(r.decks, deck.ID)
 
 	return nil
@@ -178,3 +184,7 @@ func (r *Repository) Find(name string) (Deck, error) {
 func deckFilepath(dirname, filename string) string {
 	return filepath.Join(dirname, slugify.Slugify(filename)+".json")
 }
+
+func mediaDirpath(dirname, filename string) string {
+	return filepath.Join(dirname, slugify.Slugify(filename)+MediaSuffix)
+}
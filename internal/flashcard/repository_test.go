@@ -121,6 +121,108 @@ func TestDeckRepository_List(t *testing.T) {
 	}
 }
 
+func TestDeckRepository_Settings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses default settings when the deck does not define them", func(t *testing.T) {
+		repo := newTestRepository(t, fewDecksPath, nil)
+
+		for _, deck := range repo.List() {
+			assert.Equal(t, flashcard.DefaultSettings(), deck.Settings)
+		}
+	})
+
+	t.Run("persists the deck settings", func(t *testing.T) {
+		location := test.TempCopyDir(t, fewDecksPath)
+		deck := newTestRepository(t, location, nil).List()[0]
+		deck.Settings.NewPerDay = 5
+
+		require.NoError(t, newTestRepository(t, location, nil).Save(deck))
+
+		deck, err := newTestRepository(t, location, nil).Find(deck.Name)
+		require.NoError(t, err)
+		assert.Equal(t, 5, deck.Settings.NewPerDay)
+		assert.Equal(t, flashcard.DefaultReviewsPerDay, deck.Settings.ReviewsPerDay)
+	})
+
+	t.Run("persists the deck filters", func(t *testing.T) {
+		location := test.TempCopyDir(t, fewDecksPath)
+		deck := newTestRepository(t, location, nil).List()[0]
+		deck.Filters = []flashcard.Filter{{Name: "Failed today", Query: "failed:1", Cram: true}}
+
+		require.NoError(t, newTestRepository(t, location, nil).Save(deck))
+
+		deck, err := newTestRepository(t, location, nil).Find(deck.Name)
+		require.NoError(t, err)
+		assert.Equal(t, []flashcard.Filter{{Name: "Failed today", Query: "failed:1", Cram: true}}, deck.Filters)
+	})
+
+	t.Run("persists the interrupted session", func(t *testing.T) {
+		location := test.TempCopyDir(t, fewDecksPath)
+		deck := newTestRepository(t, location, nil).List()[0]
+		session := flashcard.Session{
+			Decks:     []string{deck.Name},
+			Cards:     []flashcard.SessionCard{{ID: deck.Cards[0].ID, Learning: true}},
+			Completed: 2,
+			Answers:   [4]int{1, 0, 2, 0},
+			StartedAt: time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC),
+		}
+		deck.Session = &session
+
+		require.NoError(t, newTestRepository(t, location, nil).Save(deck))
+
+		deck, err := newTestRepository(t, location, nil).Find(deck.Name)
+		require.NoError(t, err)
+		assert.Equal(t, &session, deck.Session)
+	})
+
+	t.Run("persists the cloze cards without answer", func(t *testing.T) {
+		location := test.TempCopyDir(t, fewDecksPath)
+		deck := newTestRepository(t, location, clock.New()).List()[0]
+		deck, cards, err := deck.AddCloze("{{c1::Go}} was released in {{c2::2009}}", "")
+		require.NoError(t, err)
+
+		require.NoError(t, newTestRepository(t, location, nil).Save(deck))
+
+		deck, err = newTestRepository(t, location, nil).Find(deck.Name)
+		require.NoError(t, err)
+		siblings := deck.Siblings(cards[0])
+		require.Len(t, siblings, 2)
+		for i, card := range siblings {
+			assert.Equal(t, cards[i].ID, card.ID)
+			assert.Equal(t, cards[i].Cloze, card.Cloze)
+			assert.Empty(t, card.Answer)
+		}
+	})
+
+	t.Run("returns error when a filter has no name", func(t *testing.T) {
+		repo := newTestRepository(t, t.TempDir(), clock.New())
+		deck, err := repo.Create(test.RandomName(), nil)
+		require.NoError(t, err)
+		deck.Filters = []flashcard.Filter{{Query: "is:new"}}
+
+		assert.Error(t, repo.Save(deck))
+	})
+
+	t.Run("returns error when a note type has no templates", func(t *testing.T) {
+		repo := newTestRepository(t, t.TempDir(), clock.New())
+		deck, err := repo.Create(test.RandomName(), nil)
+		require.NoError(t, err)
+		deck.NoteTypes = []flashcard.NoteType{{Name: "Word", Fields: []string{"Word"}}}
+
+		assert.Error(t, repo.Save(deck))
+	})
+
+	t.Run("returns error when a limit is negative", func(t *testing.T) {
+		repo := newTestRepository(t, t.TempDir(), clock.New())
+		deck, err := repo.Create(test.RandomName(), nil)
+		require.NoError(t, err)
+		deck.Settings.ReviewsPerDay = -1
+
+		assert.Error(t, repo.Save(deck))
+	})
+}
+
 func TestDeckRepository_Create(t *testing.T) {
 	t.Parallel()
 
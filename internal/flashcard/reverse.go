@@ -0,0 +1,62 @@
+package flashcard
+
+import (
+	nanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// isPair reports whether the cards are the two sides of the same reversible note.
+func (c Card) isPair(other Card) bool {
+	return c.ID != other.ID && c.Note != "" && c.Note == other.Note &&
+		!c.IsCloze() && !other.IsCloze() && c.Type == "" && other.Type == ""
+}
+
+// Reverse returns the card with the question and answer of the card swapped, if it has one.
+func (d Deck) Reverse(card Card) (Card, bool) {
+	for _, c := range d.Cards {
+		if card.isPair(c) {
+			return c, true
+		}
+	}
+	return Card{}, false
+}
+
+// AddReversible adds a new card and its reverse, with the question and answer swapped.
+// Both sides are scheduled on their own.
+func (d Deck) AddReversible(question, answer string) (Deck, Card, Card) {
+	d, card := d.Add(question, answer)
+	card.Note = nanoid.Must()
+	d = d.Change(card)
+
+	reverse := NewCard(answer, question, d.clock.Now())
+	reverse.Note, reverse.Reversed = card.Note, true
+	d.Cards = append(d.Cards, reverse)
+
+	return d, card, reverse
+}
+
+// ChangeReversible updates a card and keeps its reverse in sync, creating the reverse when
+// reversible is set and the card has none, and removing it when reversible is not set.
+// The schedule and stats of both sides are kept.
+func (d Deck) ChangeReversible(card Card, reversible bool) (Deck, Card) {
+	reverse, ok := d.Reverse(card)
+
+	switch {
+	case reversible && ok:
+		reverse = rewrite(reverse, card.Answer, card.Question)
+		d = d.Change(reverse)
+
+	case reversible:
+		if card.Note == "" {
+			card.Note = nanoid.Must()
+		}
+		reverse = NewCard(card.Answer, card.Question, d.clock.Now())
+		reverse.Note, reverse.Reversed = card.Note, !card.Reversed
+		d.Cards = append(d.Cards, reverse)
+
+	case ok:
+		d = d.remove(reverse)
+		card.Note, card.Reversed = "", false
+	}
+
+	return d.Change(card), card
+}
@@ -0,0 +1,153 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestDeck_AddReversible(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("reverse", testclock.New(now), nil)
+	require.NoError(t, err)
+
+	deck, card, reverse := deck.AddReversible("dog", "cachorro")
+
+	assert.Equal(t, 2, deck.Total())
+	assert.Equal(t, "dog", card.Question)
+	assert.Equal(t, "cachorro", card.Answer)
+	assert.False(t, card.Reversed)
+	assert.Equal(t, "cachorro", reverse.Question)
+	assert.Equal(t, "dog", reverse.Answer)
+	assert.True(t, reverse.Reversed)
+	assert.NotEqual(t, card.ID, reverse.ID)
+	assert.NotEmpty(t, card.Note)
+	assert.Equal(t, card.Note, reverse.Note)
+
+	got, ok := deck.Reverse(card)
+	assert.True(t, ok)
+	assert.Equal(t, reverse.ID, got.ID)
+	got, ok = deck.Reverse(reverse)
+	assert.True(t, ok)
+	assert.Equal(t, card.ID, got.ID)
+}
+
+func TestDeck_ChangeReversible(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+
+	newReversibleDeck := func(t *testing.T) (flashcard.Deck, flashcard.Card, flashcard.Card) {
+		t.Helper()
+
+		deck, err := flashcard.NewDeck("reverse", c, []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+		require.NoError(t, err)
+		deck, card, reverse := deck.AddReversible("dog", "cachorro")
+
+		// the reverse card was studied, so it has a schedule to keep.
+		review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Query: "is:new"}))
+		for review.Left() > 0 {
+			review, err = review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+		}
+		deck = review.Deck
+		return deck, getCard(deck, card.ID), getCard(deck, reverse.ID)
+	}
+
+	t.Run(
+		"updates the reverse keeping its schedule", func(t *testing.T) {
+			deck, card, before := newReversibleDeck(t)
+			require.Equal(t, fsrs.Review, before.State)
+
+			card.Answer = "cão"
+			deck, _ = deck.ChangeReversible(card, true)
+
+			after := getCard(deck, before.ID)
+			assert.Equal(t, "cão", after.Question)
+			assert.Equal(t, "dog", after.Answer)
+			assert.Equal(t, before.Stability, after.Stability)
+			assert.Equal(t, before.Due, after.Due)
+			assert.Equal(t, before.Stats, after.Stats)
+			assert.Equal(t, "cão", getCard(deck, card.ID).Answer)
+			assert.Equal(t, 3, deck.Total())
+		},
+	)
+
+	t.Run(
+		"updates the card from its reverse", func(t *testing.T) {
+			deck, card, reverse := newReversibleDeck(t)
+
+			reverse.Question = "cão"
+			deck, _ = deck.ChangeReversible(reverse, true)
+
+			assert.Equal(t, "cão", getCard(deck, card.ID).Answer)
+			assert.Equal(t, "cão", getCard(deck, reverse.ID).Question)
+		},
+	)
+
+	t.Run(
+		"gives the rewritten reverse a new chance", func(t *testing.T) {
+			deck, card, reverse := newReversibleDeck(t)
+			reverse.Leech = true
+			deck = deck.Change(reverse)
+
+			card.Answer = "cão"
+			deck, _ = deck.ChangeReversible(card, true)
+
+			assert.False(t, getCard(deck, reverse.ID).Leech)
+		},
+	)
+
+	t.Run(
+		"adds the reverse to a card", func(t *testing.T) {
+			deck, err := flashcard.NewDeck("reverse", c, []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+			require.NoError(t, err)
+
+			deck, card := deck.ChangeReversible(deck.Cards[0], true)
+
+			reverse, ok := deck.Reverse(card)
+			require.True(t, ok)
+			assert.Equal(t, "answer", reverse.Question)
+			assert.Equal(t, "question", reverse.Answer)
+			assert.Equal(t, fsrs.New, reverse.State)
+			assert.Equal(t, 2, deck.Total())
+		},
+	)
+
+	t.Run(
+		"removes the reverse of a card", func(t *testing.T) {
+			deck, card, reverse := newReversibleDeck(t)
+
+			deck, card = deck.ChangeReversible(card, false)
+
+			_, ok := deck.Reverse(card)
+			assert.False(t, ok)
+			assert.Empty(t, getCard(deck, reverse.ID).ID)
+			assert.Equal(t, card.ID, getCard(deck, card.ID).ID)
+			assert.Equal(t, 2, deck.Total())
+		},
+	)
+}
+
+func TestDeck_RemoveReversible(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, err := flashcard.NewDeck("reverse", testclock.New(now), []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+	require.NoError(t, err)
+	deck, _, reverse := deck.AddReversible("dog", "cachorro")
+
+	deck = deck.Remove(reverse)
+
+	assert.Equal(t, 1, deck.Total())
+	assert.Equal(t, "question", deck.Cards[0].Question)
+}
@@ -3,7 +3,9 @@ package flashcard
 import (
 	"errors"
 	"math/rand"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/eliostvs/lembrol/internal/clock"
 	"github.com/open-spaced-repetition/go-fsrs/v3"
@@ -52,25 +54,237 @@ var Scores = [4]ReviewScore{
 // ErrEmptyReview indicates the review session has not more cards left to review.
 var ErrEmptyReview = errors.New("no cards in queue")
 
+// ReviewOption configures a review session.
+type ReviewOption func(*Review)
+
+// WithFilter studies the cards matching the filter query instead of the due cards.
+// An invalid query results in an empty session.
+func WithFilter(filter Filter) ReviewOption {
+	return func(r *Review) {
+		r.filter = &filter
+		r.cram = filter.Cram
+		if filter.Order != "" {
+			r.order = filter.Order
+		}
+	}
+}
+
+// WithOrder shows the cards in the given order instead of the order of the deck settings.
+func WithOrder(order ReviewOrder) ReviewOption {
+	return func(r *Review) {
+		r.order = order
+	}
+}
+
+// WithSeed makes the shuffling of the cards reproducible.
+func WithSeed(seed int64) ReviewOption {
+	return func(r *Review) {
+		r.random = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithCards studies only the given cards, the ones of the session decks matching their IDs.
+func WithCards(cards []Card) ReviewOption {
+	return func(r *Review) {
+		r.only = make(map[string]bool, len(cards))
+		for _, card := range cards {
+			r.only[card.ID] = true
+		}
+	}
+}
+
 // NewReview returns a new Review from a given a deck.
-// It gets the due cards from the deck a shuffle them.
-func NewReview(deck Deck, clock clock.Clock) Review {
-	dueCards := deck.DueCards()
-	shuffle(dueCards)
-	return Review{queue: dueCards, Deck: deck, clock: clock, scheduler: DefaultScheduler()}
+// It gets the due cards from the deck and sorts them in the order of the deck settings.
+func NewReview(deck Deck, clock clock.Clock, opts ...ReviewOption) Review {
+	return NewCrossDeckReview([]Deck{deck}, clock, opts...)
+}
+
+// NewCrossDeckReview returns a new Review studying the due cards of all the given decks together.
+// The daily limits of each deck still apply to its own cards,
+// and the order of the first deck applies to the whole session.
+func NewCrossDeckReview(decks []Deck, clock clock.Clock, opts ...ReviewOption) Review {
+	review := Review{decks: decks, clock: clock, scheduler: DefaultScheduler(), StartedAt: clock.Now()}
+	for _, opt := range opts {
+		opt(&review)
+	}
+	review.startGoal()
+
+	if review.order == "" && len(decks) > 0 {
+		review.order = decks[0].Settings.ReviewOrder
+	}
+	if review.random == nil {
+		review.random = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	for i, deck := range decks {
+		for _, card := range review.cards(deck) {
+			review.queue = append(review.queue, reviewCard{Card: card, deck: i, new: card.State == fsrs.New})
+		}
+	}
+	sortQueue(review.queue, review.order, review.random, review.scheduler, clock.Now())
+
+	if review.filter != nil && review.filter.Limit > 0 && len(review.queue) > review.filter.Limit {
+		review.queue = review.queue[:review.filter.Limit]
+	}
+
+	if len(decks) > 0 {
+		review.Deck = decks[0]
+	}
+	return review
+}
+
+// cards returns the cards of the deck to be studied in the session.
+func (r Review) cards(deck Deck) []Card {
+	var cards []Card
+	if r.filter == nil {
+		cards = deck.DueCards()
+	} else {
+		query, err := ParseQuery(r.filter.Query)
+		if err != nil {
+			return nil
+		}
+		cards = deck.Search(query)
+	}
+
+	if r.only != nil {
+		cards = slices.DeleteFunc(cards, func(card Card) bool { return !r.only[card.ID] })
+	}
+	return cards
 }
 
-func shuffle(cards []Card) {
-	rand.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+// reviewCard is a card in the review queue along with the index of its deck.
+type reviewCard struct {
+	Card
+	deck int
+	// learning cards were answered in the session and return when their next step is due.
+	learning bool
+	// new cards were not studied before the session.
+	new bool
 }
 
 // Review represents a review session.
 type Review struct {
+	// Deck is the deck changed by the last operation, before any it is the first deck of the session.
 	Deck      Deck
-	queue     []Card
+	decks     []Deck
+	queue     []reviewCard
 	clock     clock.Clock
 	scheduler *Scheduler
-	Completed int
+	filter    *Filter
+	cram      bool
+	order     ReviewOrder
+	random    *rand.Rand
+	only      map[string]bool
+	answers   [4]int
+	lapsed    []SessionCard
+	learned   int
+	goal      Goal
+	// goalStartedAt and goalCompleted are the time and the cards completed when the goal started.
+	goalStartedAt time.Time
+	goalCompleted int
+	shownAt       time.Time
+	elapsed       time.Duration
+	Completed     int
+	StartedAt     time.Time
+	// hints is the number of hints of the current card revealed.
+	hints int
+}
+
+// Show marks the current card as shown, starting to time its answer.
+func (r Review) Show() Review {
+	r.shownAt = r.clock.Now()
+	return r
+}
+
+// Elapsed returns the time spent answering the cards in the session.
+func (r Review) Elapsed() time.Duration {
+	return r.elapsed
+}
+
+// answerTime returns the time since the current card was shown, capped by the settings.
+// It is zero when the card was not shown.
+func (r Review) answerTime(settings Settings) time.Duration {
+	if r.shownAt.IsZero() {
+		return 0
+	}
+
+	duration := max(0, r.clock.Now().Sub(r.shownAt))
+	if settings.MaxAnswerSeconds > 0 {
+		duration = min(duration, time.Duration(settings.MaxAnswerSeconds)*time.Second)
+	}
+	return duration
+}
+
+// Answers returns how many times the score was given in the session.
+func (r Review) Answers(score ReviewScore) int {
+	if score < ReviewScoreAgain || score > ReviewScoreEasy {
+		return 0
+	}
+	return r.answers[score-1]
+}
+
+// Retention returns the share of the answers in the session other than again, or zero without answers.
+func (r Review) Retention() float64 {
+	var total int
+	for _, answers := range r.answers {
+		total += answers
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(total-r.answers[ReviewScoreAgain-1]) / float64(total)
+}
+
+// Lapsed returns the review cards forgotten in the session, in the order they were forgotten.
+func (r Review) Lapsed() []Card {
+	cards := make([]Card, 0, len(r.lapsed))
+	for _, lapsed := range r.lapsed {
+		index := slices.IndexFunc(r.decks[lapsed.Deck].Cards, func(card Card) bool { return card.ID == lapsed.ID })
+		if index >= 0 {
+			cards = append(cards, r.decks[lapsed.Deck].Cards[index])
+		}
+	}
+	return cards
+}
+
+// Learned returns the number of new cards that finished the learning steps in the session.
+func (r Review) Learned() int {
+	return r.learned
+}
+
+// Decks returns all the decks studied in the session.
+func (r Review) Decks() []Deck {
+	return r.decks
+}
+
+// CurrentDeck returns the deck of the card being reviewed.
+func (r Review) CurrentDeck() (Deck, error) {
+	if len(r.queue) == 0 {
+		return Deck{}, ErrEmptyReview
+	}
+	return r.decks[r.queue[0].deck], nil
+}
+
+// change replaces the deck at index without touching the decks of the previous review values.
+func (r Review) change(index int, deck Deck) Review {
+	r.decks = append([]Deck(nil), r.decks...)
+	r.decks[index] = deck
+	r.Deck = deck
+	return r
+}
+
+// workload sums the workload of all decks in the session but the given card.
+func (r Review) workload(current reviewCard) Workload {
+	workload := make(Workload)
+	for i, deck := range r.decks {
+		if i == current.deck {
+			deck = deck.Remove(current.Card)
+		}
+		for day, total := range deck.Workload() {
+			workload[day] += total
+		}
+	}
+	return workload
 }
 
 // Total returns the number of cards in the review session.
@@ -93,39 +307,177 @@ func (r Review) Current() int {
 
 // Rate scores the current card.
 func (r Review) Rate(score ReviewScore) (Review, error) {
-	card, err := r.Card()
-	if err != nil {
-		return Review{}, err
+	if len(r.queue) == 0 {
+		return Review{}, ErrEmptyReview
 	}
 
+	current := r.queue[0]
+	deck := r.decks[current.deck]
+	card := current.Card
+
 	rating := ReviewScoreToFSRSRating(score)
+	r.answers[FSRSRatingToReviewScore(rating)-1]++
+	duration := r.answerTime(deck.Settings)
+	r.elapsed += duration
+	if r.cram {
+		return r.cramRate(rating), nil
+	}
+
 	ts := r.clock.Now()
-	card = r.scheduler.ScheduleCard(card, ts, rating)
+	lapses := card.Lapses
+	if rating == fsrs.Again && card.State == fsrs.Review && !slices.ContainsFunc(r.lapsed, current.is) {
+		r.lapsed = append(slices.Clip(r.lapsed), SessionCard{Deck: current.deck, ID: card.ID})
+	}
+	scheduler := r.scheduler
+	if deck.Settings.LoadBalance {
+		scheduler = scheduler.WithWorkload(r.workload(current), deck.Settings)
+	}
+	scheduler = scheduler.WithSteps(minutes(deck.Settings.LearningSteps), minutes(deck.Settings.RelearningSteps))
+	card = scheduler.ScheduleCard(card, ts, rating)
+	card.Stats[len(card.Stats)-1].Duration = duration
+	card.Stats[len(card.Stats)-1].Hints = r.hints
 
+	if card.Lapses > lapses && deck.Settings.IsLeech(card.Lapses) {
+		card.Leech = true
+		card.Suspended = deck.Settings.LeechAction == LeechActionSuspend
+	}
+
+	r = r.change(current.deck, deck.Change(card))
+	r.queue = r.queue[1:]
+
+	// Cards in a learning step due today come back in the session.
+	if isLearning(card) && !card.Suspended && deck.Settings.SameStudyDay(card.Due, ts) {
+		r.queue = append(r.queue, reviewCard{Card: card, deck: current.deck, learning: true, new: current.new})
+	} else {
+		r.Completed++
+		if current.new {
+			r.learned++
+		}
+	}
+
+	return r.next(false), nil
+}
+
+// is reports whether the saved card is this card.
+func (c reviewCard) is(saved SessionCard) bool {
+	return c.deck == saved.Deck && c.ID == saved.ID
+}
+
+func isLearning(card Card) bool {
+	return card.State == fsrs.Learning || card.State == fsrs.Relearning
+}
+
+// next brings to the front of the queue the learning card due for longer,
+// or else the first card not in learning, or else the learning card due sooner.
+// A skipped card, the last of the queue, is only taken when it is the only one.
+func (r Review) next(skipped bool) Review {
+	r.shownAt = time.Time{}
+	r.hints = 0
+	if len(r.queue) == 0 {
+		return r
+	}
+
+	now := r.clock.Now()
+	best := -1
+	for i, c := range r.queue {
+		if !c.learning || c.Due.After(now) || skipped && i == len(r.queue)-1 {
+			continue
+		}
+		if best < 0 || c.Due.Before(r.queue[best].Due) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		best = slices.IndexFunc(r.queue, func(c reviewCard) bool { return !c.learning })
+	}
+
+	if best < 0 {
+		best = 0
+		for i, c := range r.queue {
+			if skipped && i == len(r.queue)-1 {
+				continue
+			}
+			if c.Due.Before(r.queue[best].Due) {
+				best = i
+			}
+		}
+	}
+
+	queue := make([]reviewCard, 0, len(r.queue))
+	queue = append(queue, r.queue[best])
+	queue = append(queue, r.queue[:best]...)
+	r.queue = append(queue, r.queue[best+1:]...)
+	return r
+}
+
+// Wait returns how long until the current card is due,
+// which is only the case when all the cards left are waiting for their next learning step.
+func (r Review) Wait() time.Duration {
+	if len(r.queue) == 0 || !r.queue[0].learning {
+		return 0
+	}
+	return max(0, r.queue[0].Due.Sub(r.clock.Now()))
+}
+
+// cramRate goes through the queue like Rate but leaves the schedule of the cards untouched.
+func (r Review) cramRate(rating fsrs.Rating) Review {
+	current := r.queue[0]
 	r.queue = r.queue[1:]
-	r.Deck = r.Deck.Change(card)
 
-	// For "Again" ratings, add card back to queue without advancing
 	if rating == fsrs.Again {
-		r.queue = append(r.queue, card)
+		r.queue = append(r.queue, current)
 	} else {
 		r.Completed++
 	}
 
-	return r, nil
+	r.shownAt = time.Time{}
+	r.hints = 0
+	return r
+}
+
+// Cram reports whether the session leaves the schedule of the cards untouched.
+func (r Review) Cram() bool {
+	return r.cram
 }
 
 // Skip moves the current card to the end of the queue.
 func (r Review) Skip() (Review, error) {
-	card, err := r.Card()
-	if err != nil {
-		return Review{}, err
+	if len(r.queue) == 0 {
+		return Review{}, ErrEmptyReview
+	}
+
+	r.queue = append(r.queue[1:], r.queue[0])
+
+	return r.next(true), nil
+}
+
+// Bury hides the current card until the next study day and removes it from the session.
+func (r Review) Bury() (Review, error) {
+	if len(r.queue) == 0 {
+		return Review{}, ErrEmptyReview
+	}
+
+	current := r.queue[0]
+	deck, _ := r.decks[current.deck].Bury(current.Card)
+	r = r.change(current.deck, deck)
+	r.queue = r.queue[1:]
+
+	return r.next(false), nil
+}
+
+// Suspend takes the current card out of the reviews and removes it from the session.
+func (r Review) Suspend() (Review, error) {
+	if len(r.queue) == 0 {
+		return Review{}, ErrEmptyReview
 	}
 
+	current := r.queue[0]
+	deck, _ := r.decks[current.deck].Suspend(current.Card)
+	r = r.change(current.deck, deck)
 	r.queue = r.queue[1:]
-	r.queue = append(r.queue, card)
 
-	return r, nil
+	return r.next(false), nil
 }
 
 // Card returns the card being reviewed.
@@ -133,5 +485,5 @@ func (r Review) Card() (Card, error) {
 	if len(r.queue) == 0 {
 		return Card{}, ErrEmptyReview
 	}
-	return r.queue[0], nil
+	return r.queue[0].Card, nil
 }
@@ -1,6 +1,8 @@
 package flashcard_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -46,6 +48,38 @@ func TestNewReview(t *testing.T) {
 	}
 }
 
+func TestNewCrossDeckReview(t *testing.T) {
+	t.Parallel()
+
+	now := clock.New()
+	large := newTestDeck(t, largeDeck, now)
+	small := newTestDeck(t, smallDeck, now)
+
+	review := flashcard.NewCrossDeckReview([]flashcard.Deck{large, small}, now)
+
+	assert.Equal(t, 10, review.Total())
+
+	for review.Left() > 0 {
+		card, err := review.Card()
+		require.NoError(t, err)
+		deck, err := review.CurrentDeck()
+		require.NoError(t, err)
+		assert.Equal(t, card, getCard(deck, card.ID))
+
+		review, err = review.Rate(flashcard.ReviewScoreGood)
+		require.NoError(t, err)
+
+		assert.Equal(t, deck.Name, review.Deck.Name)
+		assert.Greater(t, len(getCard(review.Deck, card.ID).Stats), len(card.Stats))
+	}
+
+	require.Len(t, review.Decks(), 2)
+	for _, deck := range review.Decks() {
+		assert.False(t, deck.HasDueCards(), deck.Name)
+	}
+	assert.True(t, large.HasDueCards(), "keeps the original decks untouched")
+}
+
 func TestReview_Rate(t *testing.T) {
 	t.Parallel()
 
@@ -90,12 +124,26 @@ func TestReview_Rate(t *testing.T) {
 					},
 				},
 				{
-					name: "removes card from the queue",
+					name: "re-queues the card while it has learning steps left",
 					args: args{
 						deck:  largeDeck,
 						time:  time.Now(),
 						score: flashcard.ReviewScoreGood,
 					},
+					want: want{
+						total:     7,
+						left:      7,
+						current:   1,
+						completed: 0,
+					},
+				},
+				{
+					name: "removes card from the queue",
+					args: args{
+						deck:  largeDeck,
+						time:  time.Now(),
+						score: flashcard.ReviewScoreEasy,
+					},
 					want: want{
 						total:     7,
 						left:      6,
@@ -108,7 +156,7 @@ func TestReview_Rate(t *testing.T) {
 					args: args{
 						deck:  singleDeck,
 						time:  time.Now(),
-						score: flashcard.ReviewScoreGood,
+						score: flashcard.ReviewScoreEasy,
 					},
 					want: want{
 						total:     1,
@@ -143,6 +191,473 @@ func TestReview_Rate(t *testing.T) {
 	)
 }
 
+func TestReview_Rate_LearningSteps(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	newStepsReview := func(t *testing.T, c clock.Clock, cards ...flashcard.Card) flashcard.Review {
+		t.Helper()
+
+		deck, err := flashcard.NewDeck("steps", c, cards)
+		require.NoError(t, err)
+		return flashcard.NewReview(deck, c, flashcard.WithOrder(flashcard.OrderDue))
+	}
+
+	rate := func(t *testing.T, review flashcard.Review, score flashcard.ReviewScore) (flashcard.Review, flashcard.Card) {
+		t.Helper()
+
+		card, err := review.Card()
+		require.NoError(t, err)
+		review, err = review.Rate(score)
+		require.NoError(t, err)
+		return review, getCard(review.Deck, card.ID)
+	}
+
+	t.Run(
+		"moves a new card through the learning steps", func(t *testing.T) {
+			review := newStepsReview(t, testclock.New(now), flashcard.NewCard("question", "answer", now))
+
+			review, card := rate(t, review, flashcard.ReviewScoreAgain)
+			assert.Equal(t, fsrs.Learning, card.State)
+			assert.Equal(t, 0, card.Step)
+			assert.Equal(t, now.Add(time.Minute), card.Due)
+			assert.Equal(t, time.Minute, review.Wait())
+
+			review, card = rate(t, review, flashcard.ReviewScoreHard)
+			assert.Equal(t, 0, card.Step)
+			assert.Equal(t, now.Add(5*time.Minute+30*time.Second), card.Due)
+
+			review, card = rate(t, review, flashcard.ReviewScoreGood)
+			assert.Equal(t, fsrs.Learning, card.State)
+			assert.Equal(t, 1, card.Step)
+			assert.Equal(t, now.Add(10*time.Minute), card.Due)
+			assert.Equal(t, 0, review.Completed)
+
+			review, card = rate(t, review, flashcard.ReviewScoreGood)
+			assert.Equal(t, fsrs.Review, card.State)
+			assert.Equal(t, 0, card.Step)
+			assert.Positive(t, card.ScheduledDays)
+			assert.Equal(t, 1, review.Completed)
+			assert.Equal(t, 0, review.Left())
+		},
+	)
+
+	t.Run(
+		"moves a forgotten card through the relearning steps", func(t *testing.T) {
+			review := newStepsReview(t, testclock.New(now), newReviewCard("question", 10, now.Add(-10*24*time.Hour), now))
+
+			review, card := rate(t, review, flashcard.ReviewScoreAgain)
+			assert.Equal(t, fsrs.Relearning, card.State)
+			assert.Equal(t, now.Add(10*time.Minute), card.Due)
+			assert.Equal(t, 1, review.Left())
+
+			review, card = rate(t, review, flashcard.ReviewScoreGood)
+			assert.Equal(t, fsrs.Review, card.State)
+			assert.Equal(t, 1, review.Completed)
+		},
+	)
+
+	t.Run(
+		"graduates the card at once without steps", func(t *testing.T) {
+			c := testclock.New(now)
+			deck, err := flashcard.NewDeck("steps", c, []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+			require.NoError(t, err)
+			deck.Settings.LearningSteps = nil
+
+			review, card := rate(t, flashcard.NewReview(deck, c), flashcard.ReviewScoreAgain)
+			assert.Equal(t, fsrs.Review, card.State)
+			assert.Equal(t, 1, review.Completed)
+		},
+	)
+
+	t.Run(
+		"leaves the steps due on the next study day for a later session", func(t *testing.T) {
+			c := testclock.New(now)
+			deck, err := flashcard.NewDeck("steps", c, []flashcard.Card{flashcard.NewCard("question", "answer", now)})
+			require.NoError(t, err)
+			deck.Settings.LearningSteps = []int{24 * 60}
+
+			review, card := rate(t, flashcard.NewReview(deck, c), flashcard.ReviewScoreAgain)
+			assert.Equal(t, fsrs.Learning, card.State)
+			assert.Equal(t, 1, review.Completed)
+			assert.Equal(t, 0, review.Left())
+		},
+	)
+
+	t.Run(
+		"shows the other cards while the learning step is not due", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := newStepsReview(
+				t, c,
+				newReviewCard("First", 10, now.Add(-20*24*time.Hour), now.Add(-2*time.Hour)),
+				newReviewCard("Second", 10, now.Add(-20*24*time.Hour), now.Add(-time.Hour)),
+			)
+
+			review, _ = rate(t, review, flashcard.ReviewScoreAgain)
+			card, err := review.Card()
+			require.NoError(t, err)
+			assert.Equal(t, "Second", card.Question)
+			assert.Zero(t, review.Wait())
+
+			c.now = now.Add(11 * time.Minute)
+			review, _ = rate(t, review, flashcard.ReviewScoreAgain)
+			card, err = review.Card()
+			require.NoError(t, err)
+			assert.Equal(t, "First", card.Question)
+			assert.Zero(t, review.Wait())
+
+			review, err = review.Skip()
+			require.NoError(t, err)
+			card, err = review.Card()
+			require.NoError(t, err)
+			assert.Equal(t, "Second", card.Question)
+			assert.Equal(t, 10*time.Minute, review.Wait())
+		},
+	)
+}
+
+// manualClock is a clock whose time is changed by the test.
+type manualClock struct {
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	return c.now
+}
+
+func (c *manualClock) Sleep(time.Duration) {}
+
+func TestReview_Rate_Duration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	// answer shows the current card, waits and rates it as easy.
+	answer := func(t *testing.T, c *manualClock, review flashcard.Review, wait time.Duration) (flashcard.Review, flashcard.Stats) {
+		t.Helper()
+
+		card, err := review.Card()
+		require.NoError(t, err)
+		review = review.Show()
+		c.now = c.now.Add(wait)
+		review, err = review.Rate(flashcard.ReviewScoreEasy)
+		require.NoError(t, err)
+
+		stats := getCard(review.Deck, card.ID).Stats
+		return review, stats[len(stats)-1]
+	}
+
+	t.Run(
+		"records the time from the question shown to the rating", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := newTestReview(t, smallDeck, c)
+
+			review, stats := answer(t, c, review, 12*time.Second)
+			assert.Equal(t, 12*time.Second, stats.Duration)
+
+			review, stats = answer(t, c, review, 8*time.Second)
+			assert.Equal(t, 8*time.Second, stats.Duration)
+			assert.Equal(t, 20*time.Second, review.Elapsed())
+		},
+	)
+
+	t.Run(
+		"caps the time of an idle answer", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := newTestReview(t, smallDeck, c)
+
+			review, stats := answer(t, c, review, time.Hour)
+
+			assert.Equal(t, flashcard.DefaultMaxAnswerSeconds*time.Second, stats.Duration)
+			assert.Equal(t, flashcard.DefaultMaxAnswerSeconds*time.Second, review.Elapsed())
+		},
+	)
+
+	t.Run(
+		"does not record the time when the card was not shown", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review := newTestReview(t, smallDeck, c)
+			card, err := review.Card()
+			require.NoError(t, err)
+
+			c.now = now.Add(10 * time.Second)
+			review, err = review.Rate(flashcard.ReviewScoreEasy)
+			require.NoError(t, err)
+
+			stats := getCard(review.Deck, card.ID).Stats
+			assert.Zero(t, stats[len(stats)-1].Duration)
+			assert.Zero(t, review.Elapsed())
+		},
+	)
+
+	t.Run(
+		"keeps the time of the saved session", func(t *testing.T) {
+			c := &manualClock{now: now}
+			review, _ := answer(t, c, newTestReview(t, smallDeck, c), 15*time.Second)
+
+			resumed, err := flashcard.ResumeReview(review.Decks(), review.Session(), c)
+			require.NoError(t, err)
+
+			assert.Equal(t, 15*time.Second, resumed.Elapsed())
+		},
+	)
+}
+
+func TestReview_Summary(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	newSummaryReview := func(t *testing.T) flashcard.Review {
+		t.Helper()
+
+		c := testclock.New(now)
+		deck, err := flashcard.NewDeck(
+			"summary", c, []flashcard.Card{
+				newReviewCard("Forgotten", 10, now.AddDate(0, 0, -20), now.Add(-2*time.Hour)),
+				newReviewCard("Remembered", 10, now.AddDate(0, 0, -20), now.Add(-time.Hour)),
+				flashcard.NewCard("New", "answer", now),
+			},
+		)
+		require.NoError(t, err)
+		deck.Settings.RelearningSteps = nil
+
+		review := flashcard.NewReview(deck, c, flashcard.WithOrder(flashcard.OrderDue))
+		for _, score := range []flashcard.ReviewScore{flashcard.ReviewScoreAgain, flashcard.ReviewScoreGood, flashcard.ReviewScoreEasy} {
+			review, err = review.Rate(score)
+			require.NoError(t, err)
+		}
+		require.Zero(t, review.Left())
+		return review
+	}
+
+	t.Run(
+		"collects the outcome of the session", func(t *testing.T) {
+			review := newSummaryReview(t)
+
+			require.Len(t, review.Lapsed(), 1)
+			assert.Equal(t, "Forgotten", review.Lapsed()[0].Question)
+			assert.Equal(t, fsrs.Review, review.Lapsed()[0].State)
+			assert.Equal(t, 1, review.Learned())
+			assert.InDelta(t, 2.0/3, review.Retention(), 0.001)
+		},
+	)
+
+	t.Run(
+		"has no retention without answers", func(t *testing.T) {
+			review := newTestReview(t, smallDeck, testclock.New(now))
+
+			assert.Zero(t, review.Retention())
+			assert.Empty(t, review.Lapsed())
+		},
+	)
+
+	t.Run(
+		"studies the lapsed cards again", func(t *testing.T) {
+			review := newSummaryReview(t)
+			c := testclock.New(now)
+
+			restudy := flashcard.NewCrossDeckReview(
+				review.Decks(), c, flashcard.WithFilter(flashcard.Filter{Cram: true}), flashcard.WithCards(review.Lapsed()),
+			)
+
+			assert.Equal(t, 1, restudy.Total())
+			card, err := restudy.Card()
+			require.NoError(t, err)
+			assert.Equal(t, "Forgotten", card.Question)
+		},
+	)
+
+	t.Run(
+		"keeps the outcome in the saved session", func(t *testing.T) {
+			c := testclock.New(now)
+			deck, err := flashcard.NewDeck(
+				"summary", c, []flashcard.Card{
+					newReviewCard("Forgotten", 10, now.AddDate(0, 0, -20), now.Add(-2*time.Hour)),
+					newReviewCard("Remembered", 10, now.AddDate(0, 0, -20), now.Add(-time.Hour)),
+				},
+			)
+			require.NoError(t, err)
+
+			review := flashcard.NewReview(deck, c, flashcard.WithOrder(flashcard.OrderDue))
+			review, err = review.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+
+			resumed, err := flashcard.ResumeReview(review.Decks(), review.Session(), c)
+			require.NoError(t, err)
+
+			require.Len(t, resumed.Lapsed(), 1)
+			assert.Equal(t, "Forgotten", resumed.Lapsed()[0].Question)
+		},
+	)
+}
+
+func TestReview_Rate_Leech(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	newLapsingReview := func(t *testing.T, action flashcard.LeechAction, lapses uint64) flashcard.Review {
+		t.Helper()
+
+		card := flashcard.NewCard("question", "answer", now.Add(-48*time.Hour))
+		card.State = fsrs.Review
+		card.Stability = 10
+		card.Difficulty = 5
+		card.Reps = 10
+		card.Lapses = lapses
+
+		deck, err := flashcard.NewDeck("leech", testclock.New(now), []flashcard.Card{card})
+		require.NoError(t, err)
+		deck.Settings.LeechAction = action
+
+		return flashcard.NewReview(deck, testclock.New(now))
+	}
+
+	t.Run(
+		"marks the card as leech when it reaches the threshold", func(t *testing.T) {
+			review := newLapsingReview(t, flashcard.LeechActionTag, flashcard.DefaultLeechThreshold-1)
+			card, _ := review.Card()
+
+			review, err := review.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+
+			card = getCard(review.Deck, card.ID)
+			assert.True(t, card.Leech)
+			assert.False(t, card.Suspended)
+			assert.Equal(t, 1, review.Left())
+		},
+	)
+
+	t.Run(
+		"suspends the card when the leech action is suspend", func(t *testing.T) {
+			review := newLapsingReview(t, flashcard.LeechActionSuspend, flashcard.DefaultLeechThreshold-1)
+			card, _ := review.Card()
+
+			review, err := review.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+
+			card = getCard(review.Deck, card.ID)
+			assert.True(t, card.Leech)
+			assert.True(t, card.Suspended)
+			assert.Equal(t, 0, review.Left())
+		},
+	)
+
+	t.Run(
+		"does not mark the card before the threshold", func(t *testing.T) {
+			review := newLapsingReview(t, flashcard.LeechActionSuspend, 0)
+			card, _ := review.Card()
+
+			review, err := review.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+
+			card = getCard(review.Deck, card.ID)
+			assert.False(t, card.Leech)
+			assert.False(t, card.Suspended)
+		},
+	)
+}
+
+func TestReview_Rate_LoadBalance(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	// study reviews every due card as good for ten days and returns the forecast of the following month.
+	study := func(t *testing.T, loadBalance bool) []int {
+		t.Helper()
+
+		cards := make([]flashcard.Card, 0, 300)
+		for i := range 300 {
+			cards = append(cards, flashcard.NewCard(fmt.Sprintf("question %d", i), "answer", start))
+		}
+
+		var deck flashcard.Deck
+		for day := range 10 {
+			now := testclock.New(start.AddDate(0, 0, day))
+			next, err := flashcard.NewDeck("balance", now, cards)
+			require.NoError(t, err)
+			next.Settings.NewPerDay = len(cards)
+			next.Settings.ReviewsPerDay = len(cards)
+			next.Settings.LoadBalance = loadBalance
+
+			review := flashcard.NewReview(next, now)
+			for review.Left() > 0 {
+				review, err = review.Rate(flashcard.ReviewScoreGood)
+				require.NoError(t, err)
+			}
+			deck, cards = review.Deck, review.Deck.Cards
+		}
+
+		return deck.Forecast(30)
+	}
+
+	clustered := study(t, false)
+	balanced := study(t, true)
+
+	assert.Equal(t, sum(clustered), sum(balanced))
+	assert.Less(t, slices.Max(balanced), slices.Max(clustered))
+	assert.Less(t, variance(balanced), variance(clustered))
+}
+
+func TestReview_WithFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"studies the cards matching the query", func(t *testing.T) {
+			c := testclock.New(time.Now())
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithFilter(flashcard.Filter{Query: "-is:due"}))
+
+			assert.Equal(t, 0, review.Total())
+
+			review = flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithFilter(flashcard.Filter{Query: ""}))
+
+			assert.Equal(t, 7, review.Total())
+		},
+	)
+
+	t.Run(
+		"limits the number of cards", func(t *testing.T) {
+			c := testclock.New(time.Now())
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithFilter(flashcard.Filter{Limit: 3}))
+
+			assert.Equal(t, 3, review.Total())
+		},
+	)
+
+	t.Run(
+		"is empty when the query is invalid", func(t *testing.T) {
+			c := testclock.New(time.Now())
+			review := flashcard.NewReview(newTestDeck(t, largeDeck, c), c, flashcard.WithFilter(flashcard.Filter{Query: "is:nothing"}))
+
+			assert.Equal(t, 0, review.Total())
+		},
+	)
+
+	t.Run(
+		"does not change the schedule in cram mode", func(t *testing.T) {
+			c := testclock.New(time.Now())
+			deck := newTestDeck(t, smallDeck, c)
+			review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Cram: true}))
+
+			review, err := review.Rate(flashcard.ReviewScoreAgain)
+			require.NoError(t, err)
+			assert.Equal(t, 3, review.Left())
+
+			for review.Left() > 0 {
+				review, err = review.Rate(flashcard.ReviewScoreEasy)
+				require.NoError(t, err)
+			}
+
+			assert.True(t, review.Cram())
+			assert.Equal(t, 3, review.Completed)
+			assert.Equal(t, deck, review.Deck)
+		},
+	)
+}
+
 func TestReview_Skip(t *testing.T) {
 	t.Parallel()
 
@@ -179,6 +694,64 @@ func TestReview_Skip(t *testing.T) {
 	)
 }
 
+func TestReview_Bury(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"returns error when queue is empty", func(t *testing.T) {
+			review := newTestReview(t, largeDeck, testclock.New(beforeOldestCard))
+
+			review, err := review.Bury()
+
+			assert.Equal(t, flashcard.Review{}, review)
+			assert.ErrorIs(t, err, flashcard.ErrEmptyReview)
+		},
+	)
+
+	t.Run(
+		"removes the card from the session until the next day", func(t *testing.T) {
+			review := newTestReview(t, smallDeck, clock.New())
+			card, _ := review.Card()
+
+			review, err := review.Bury()
+			require.NoError(t, err)
+
+			assert.Equal(t, 2, review.Total())
+			assert.Equal(t, 0, review.Completed)
+			assert.True(t, getCard(review.Deck, card.ID).IsBuried(time.Now()))
+			assert.NotContains(t, review.Deck.DueCards(), getCard(review.Deck, card.ID))
+		},
+	)
+}
+
+func TestReview_Suspend(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"returns error when queue is empty", func(t *testing.T) {
+			review := newTestReview(t, largeDeck, testclock.New(beforeOldestCard))
+
+			review, err := review.Suspend()
+
+			assert.Equal(t, flashcard.Review{}, review)
+			assert.ErrorIs(t, err, flashcard.ErrEmptyReview)
+		},
+	)
+
+	t.Run(
+		"removes the card from the session", func(t *testing.T) {
+			review := newTestReview(t, smallDeck, clock.New())
+			card, _ := review.Card()
+
+			review, err := review.Suspend()
+			require.NoError(t, err)
+
+			assert.Equal(t, 2, review.Left())
+			assert.True(t, getCard(review.Deck, card.ID).Suspended)
+		},
+	)
+}
+
 func TestReviewScoreToFSRSRating(t *testing.T) {
 	tests := []struct {
 		score    flashcard.ReviewScore
@@ -231,6 +804,24 @@ func getCard(deck flashcard.Deck, id string) flashcard.Card {
 	return flashcard.Card{}
 }
 
+func sum(values []int) int {
+	var total int
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+func variance(values []int) float64 {
+	mean := float64(sum(values)) / float64(len(values))
+
+	var total float64
+	for _, v := range values {
+		total += (float64(v) - mean) * (float64(v) - mean)
+	}
+	return total / float64(len(values))
+}
+
 func newTestReview(t *testing.T, file string, c clock.Clock) flashcard.Review {
 	t.Helper()
 
@@ -1,6 +1,7 @@
 package flashcard
 
 import (
+	"math"
 	"time"
 
 	"github.com/open-spaced-repetition/go-fsrs/v3"
@@ -9,12 +10,23 @@ import (
 // Scheduler wraps the FSRS algorithm for card scheduling.
 type Scheduler struct {
 	fsrs *fsrs.FSRS
+	// longTerm schedules in days the cards graduating from the learning steps.
+	longTerm        *fsrs.FSRS
+	workload        Workload
+	settings        Settings
+	steps           bool
+	learningSteps   []time.Duration
+	relearningSteps []time.Duration
 }
 
 // NewScheduler creates a new FSRS-based scheduler with the given parameters.
 func NewScheduler(params fsrs.Parameters) *Scheduler {
+	longTerm := params
+	longTerm.EnableShortTerm = false
+
 	return &Scheduler{
-		fsrs: fsrs.NewFSRS(params),
+		fsrs:     fsrs.NewFSRS(params),
+		longTerm: fsrs.NewFSRS(longTerm),
 	}
 }
 
@@ -23,14 +35,154 @@ func DefaultScheduler() *Scheduler {
 	return NewScheduler(fsrs.DefaultParam())
 }
 
+// WithWorkload returns a scheduler that balances the review load,
+// choosing inside the fuzz range the study day with the fewest cards already due.
+func (s *Scheduler) WithWorkload(workload Workload, settings Settings) *Scheduler {
+	next := *s
+	next.workload, next.settings = workload, settings
+	return &next
+}
+
+// WithSteps returns a scheduler that keeps the new and the forgotten cards in the given steps
+// before they graduate to review, instead of the fixed short-term intervals of FSRS.
+// A card without steps graduates on the first answer.
+func (s *Scheduler) WithSteps(learning, relearning []time.Duration) *Scheduler {
+	next := *s
+	next.steps, next.learningSteps, next.relearningSteps = true, learning, relearning
+	return &next
+}
+
 // ScheduleCard schedules a card review with the given rating.
 func (s *Scheduler) ScheduleCard(card Card, now time.Time, rating fsrs.Rating) Card {
 	fsrsCard := s.cardToFSRS(card)
 	info := s.fsrs.Next(fsrsCard, now, rating)
 	updatedCard := s.fsrsToCard(info.Card, card)
+	if s.steps {
+		updatedCard = s.step(card, updatedCard, now, rating)
+	}
+	updatedCard = s.balance(updatedCard, now)
 	return updatedCard.AddStats(NewStats(now, rating, card, updatedCard))
 }
 
+// step moves the card through the learning steps, Again goes back to the first step,
+// Hard repeats the current one and Good advances to the next. Easy, or Good at the last step, graduates the card.
+func (s *Scheduler) step(before, after Card, now time.Time, rating fsrs.Rating) Card {
+	steps, state := s.learningSteps, fsrs.Learning
+	switch {
+	case before.State == fsrs.Review && rating != fsrs.Again:
+		return after
+	case before.State == fsrs.Review || before.State == fsrs.Relearning:
+		steps, state = s.relearningSteps, fsrs.Relearning
+	}
+
+	var index int
+	switch rating {
+	case fsrs.Again:
+		index = 0
+	case fsrs.Hard:
+		index = min(before.Step, len(steps)-1)
+	case fsrs.Good:
+		index = before.Step + 1
+	default:
+		index = len(steps)
+	}
+
+	if index < 0 || index >= len(steps) {
+		return s.graduate(before, after, now, rating)
+	}
+
+	delay := steps[index]
+	if rating == fsrs.Hard {
+		delay = hardDelay(steps, index)
+	}
+
+	after.State = state
+	after.Step = index
+	after.ScheduledDays = 0
+	after.Due = now.Add(delay)
+	return after
+}
+
+// graduate schedules the card to review in days.
+func (s *Scheduler) graduate(before, after Card, now time.Time, rating fsrs.Rating) Card {
+	if after.State != fsrs.Review {
+		info := s.longTerm.Next(s.cardToFSRS(before), now, rating)
+		after = s.fsrsToCard(info.Card, before)
+	}
+	after.Step = 0
+	return after
+}
+
+// hardDelay is the delay of a Hard answer, between the first two steps on the first step
+// and the same delay of the step on the others.
+func hardDelay(steps []time.Duration, index int) time.Duration {
+	switch {
+	case index > 0:
+		return steps[index]
+	case len(steps) > 1:
+		return (steps[0] + steps[1]) / 2
+	default:
+		return min(steps[0]*3/2, steps[0]+24*time.Hour)
+	}
+}
+
+// balance moves a review card to the least busy day of its fuzz range,
+// preferring the days closer to the original interval on a tie.
+func (s *Scheduler) balance(card Card, now time.Time) Card {
+	if s.workload == nil || card.State != fsrs.Review {
+		return card
+	}
+
+	interval := int(card.ScheduledDays)
+	minIvl, maxIvl := fuzzRange(float64(interval), float64(card.ElapsedDays), s.fsrs.MaximumInterval)
+
+	best, bestLoad := interval, math.MaxInt
+	for ivl := minIvl; ivl <= maxIvl; ivl++ {
+		load := s.workload[s.settings.StudyDay(due(now, ivl))]
+		if load < bestLoad || load == bestLoad && abs(ivl-interval) < abs(best-interval) {
+			best, bestLoad = ivl, load
+		}
+	}
+
+	card.ScheduledDays = uint64(best)
+	card.Due = due(now, best)
+	return card
+}
+
+func due(now time.Time, interval int) time.Time {
+	return now.Add(time.Duration(interval) * 24 * time.Hour)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// fuzzRange returns the interval range FSRS uses when fuzzing an interval, see fsrs.FUZZ_RANGES.
+// Short intervals are not fuzzed, so the range holds only the interval itself.
+func fuzzRange(interval, elapsedDays, maximumInterval float64) (int, int) {
+	if interval < 2.5 {
+		return int(interval), int(interval)
+	}
+
+	delta := 1.0
+	for _, r := range fsrs.FUZZ_RANGES {
+		delta += r.Factor * math.Max(math.Min(interval, r.End)-r.Start, 0.0)
+	}
+
+	interval = math.Min(interval, maximumInterval)
+	minIvl := math.Max(2, math.Round(interval-delta))
+	maxIvl := math.Min(math.Round(interval+delta), maximumInterval)
+	if interval > elapsedDays {
+		minIvl = math.Max(minIvl, elapsedDays+1)
+	}
+	minIvl = math.Min(minIvl, maxIvl)
+
+	return int(minIvl), int(maxIvl)
+}
+
 // GetRetrievability returns the current retrievability of a card.
 func (s *Scheduler) GetRetrievability(card Card, now time.Time) float64 {
 	fsrsCard := s.cardToFSRS(card)
@@ -54,22 +206,17 @@ func (s *Scheduler) cardToFSRS(card Card) fsrs.Card {
 
 // fsrsToCard converts an FSRS Card back to a lembrol Card, preserving metadata.
 func (s *Scheduler) fsrsToCard(fsrsCard fsrs.Card, original Card) Card {
-	return Card{
-		ID:       original.ID,
-		Question: original.Question,
-		Answer:   original.Answer,
-		Stats:    original.Stats,
-		// FSRS fields
-		Due:           fsrsCard.Due,
-		Stability:     fsrsCard.Stability,
-		Difficulty:    fsrsCard.Difficulty,
-		ElapsedDays:   fsrsCard.ElapsedDays,
-		ScheduledDays: fsrsCard.ScheduledDays,
-		Reps:          fsrsCard.Reps,
-		Lapses:        fsrsCard.Lapses,
-		State:         fsrsCard.State,
-		LastReview:    fsrsCard.LastReview,
-	}
+	card := original
+	card.Due = fsrsCard.Due
+	card.Stability = fsrsCard.Stability
+	card.Difficulty = fsrsCard.Difficulty
+	card.ElapsedDays = fsrsCard.ElapsedDays
+	card.ScheduledDays = fsrsCard.ScheduledDays
+	card.Reps = fsrsCard.Reps
+	card.Lapses = fsrsCard.Lapses
+	card.State = fsrsCard.State
+	card.LastReview = fsrsCard.LastReview
+	return card
 }
 
 // ReviewScoreToFSRSRating converts the current ReviewScore to FSRS Rating.
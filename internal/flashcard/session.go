@@ -0,0 +1,122 @@
+package flashcard
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/eliostvs/lembrol/internal/clock"
+)
+
+// ErrSessionMismatch is returned by ResumeReview when the session was started with other decks.
+var ErrSessionMismatch = errors.New("session belongs to other decks")
+
+// Session is the saved state of an interrupted review.
+type Session struct {
+	// Decks are the names of the decks studied in the session.
+	Decks []string `json:"decks" validate:"required"`
+	// Cards are the cards left in the session, in the order they are shown.
+	Cards     []SessionCard `json:"cards" validate:"dive"`
+	Completed int           `json:"completed" validate:"gte=0"`
+	// Answers counts the answers given by score, from again to easy.
+	Answers   [4]int    `json:"answers"`
+	StartedAt time.Time `json:"started_at"`
+	// Elapsed is the time spent answering the cards.
+	Elapsed time.Duration `json:"elapsed"`
+	// Lapsed are the review cards forgotten in the session.
+	Lapsed  []SessionCard `json:"lapsed,omitempty" validate:"dive"`
+	Learned int           `json:"learned,omitempty" validate:"gte=0"`
+	Filter  *Filter       `json:"filter,omitempty"`
+}
+
+// SessionCard is a card of a saved session.
+type SessionCard struct {
+	// Deck is the index of the deck of the card in the session decks.
+	Deck     int    `json:"deck" validate:"gte=0"`
+	ID       string `json:"id" validate:"required"`
+	Learning bool   `json:"learning,omitempty"`
+	New      bool   `json:"new,omitempty"`
+}
+
+// Matches reports whether the session was started with the given decks.
+func (s Session) Matches(decks []Deck) bool {
+	names := make([]string, 0, len(decks))
+	for _, deck := range decks {
+		names = append(names, deck.Name)
+	}
+	return slices.Equal(s.Decks, names)
+}
+
+// Session returns the state of the review to be resumed later.
+func (r Review) Session() Session {
+	session := Session{
+		Completed: r.Completed,
+		Answers:   r.answers,
+		StartedAt: r.StartedAt,
+		Elapsed:   r.elapsed,
+		Lapsed:    r.lapsed,
+		Learned:   r.learned,
+		Filter:    r.filter,
+	}
+
+	for _, deck := range r.decks {
+		session.Decks = append(session.Decks, deck.Name)
+	}
+
+	for _, card := range r.queue {
+		session.Cards = append(session.Cards, SessionCard{Deck: card.deck, ID: card.ID, Learning: card.learning, New: card.new})
+	}
+
+	return session
+}
+
+// ResumeReview continues a saved session with the current cards of the decks.
+// The cards deleted or suspended since the session was saved are left out.
+// The options apply to the resumed review as they do to a new one.
+func ResumeReview(decks []Deck, session Session, clock clock.Clock, opts ...ReviewOption) (Review, error) {
+	if !session.Matches(decks) {
+		return Review{}, ErrSessionMismatch
+	}
+
+	review := Review{
+		decks:     decks,
+		clock:     clock,
+		scheduler: DefaultScheduler(),
+		Completed: session.Completed,
+		answers:   session.Answers,
+		StartedAt: session.StartedAt,
+		elapsed:   session.Elapsed,
+		learned:   session.Learned,
+	}
+	if session.Filter != nil {
+		WithFilter(*session.Filter)(&review)
+	}
+	for _, opt := range opts {
+		opt(&review)
+	}
+	review.startGoal()
+
+	for _, lapsed := range session.Lapsed {
+		if lapsed.Deck >= 0 && lapsed.Deck < len(decks) {
+			review.lapsed = append(review.lapsed, lapsed)
+		}
+	}
+
+	for _, saved := range session.Cards {
+		if saved.Deck < 0 || saved.Deck >= len(decks) {
+			continue
+		}
+
+		index := slices.IndexFunc(decks[saved.Deck].Cards, func(card Card) bool { return card.ID == saved.ID })
+		if index < 0 || decks[saved.Deck].Cards[index].Suspended {
+			continue
+		}
+
+		review.queue = append(review.queue, reviewCard{Card: decks[saved.Deck].Cards[index], deck: saved.Deck, learning: saved.Learning, new: saved.New})
+	}
+
+	if len(decks) > 0 {
+		review.Deck = decks[0]
+	}
+	return review.next(false), nil
+}
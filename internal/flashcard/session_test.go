@@ -0,0 +1,89 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestResumeReview(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+
+	newInterruptedReview := func(t *testing.T) flashcard.Review {
+		t.Helper()
+
+		review := newTestReview(t, largeDeck, c)
+		review, err := review.Rate(flashcard.ReviewScoreAgain)
+		require.NoError(t, err)
+		review, err = review.Rate(flashcard.ReviewScoreEasy)
+		require.NoError(t, err)
+		review, err = review.Skip()
+		require.NoError(t, err)
+		return review
+	}
+
+	t.Run(
+		"continues the session where it stopped", func(t *testing.T) {
+			review := newInterruptedReview(t)
+
+			resumed, err := flashcard.ResumeReview(review.Decks(), review.Session(), testclock.New(now.Add(time.Hour)))
+			require.NoError(t, err)
+
+			assert.Equal(t, review.Left(), resumed.Left())
+			assert.Equal(t, review.Total(), resumed.Total())
+			assert.Equal(t, 1, resumed.Completed)
+			assert.Equal(t, 1, resumed.Answers(flashcard.ReviewScoreAgain))
+			assert.Equal(t, 1, resumed.Answers(flashcard.ReviewScoreEasy))
+			assert.Equal(t, now, resumed.StartedAt)
+
+			// the card answered again is due after an hour, so it comes first.
+			card, err := resumed.Card()
+			require.NoError(t, err)
+			assert.Equal(t, flashcard.ReviewScoreAgain, flashcard.FSRSRatingToReviewScore(card.Stats[len(card.Stats)-1].Rating))
+		},
+	)
+
+	t.Run(
+		"leaves out the cards deleted since", func(t *testing.T) {
+			review := newInterruptedReview(t)
+			card, err := review.Card()
+			require.NoError(t, err)
+
+			decks := []flashcard.Deck{review.Deck.Remove(card)}
+			resumed, err := flashcard.ResumeReview(decks, review.Session(), c)
+			require.NoError(t, err)
+
+			assert.Equal(t, review.Left()-1, resumed.Left())
+		},
+	)
+
+	t.Run(
+		"keeps the filter of the session", func(t *testing.T) {
+			deck := newTestDeck(t, largeDeck, c)
+			review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Name: "cram", Cram: true}))
+
+			resumed, err := flashcard.ResumeReview([]flashcard.Deck{deck}, review.Session(), c)
+			require.NoError(t, err)
+
+			assert.True(t, resumed.Cram())
+		},
+	)
+
+	t.Run(
+		"returns error when the decks are not the ones of the session", func(t *testing.T) {
+			review := newInterruptedReview(t)
+
+			_, err := flashcard.ResumeReview([]flashcard.Deck{newTestDeck(t, smallDeck, c)}, review.Session(), c)
+
+			assert.ErrorIs(t, err, flashcard.ErrSessionMismatch)
+		},
+	)
+}
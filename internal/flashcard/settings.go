@@ -0,0 +1,150 @@
+package flashcard
+
+import (
+	"sync"
+	"time"
+)
+
+// Default study options used when a deck does not define its own.
+const (
+	DefaultNewPerDay        = 20
+	DefaultReviewsPerDay    = 200
+	DefaultDayStartsAt      = 4
+	DefaultLeechThreshold   = 8
+	DefaultMaxAnswerSeconds = 60
+)
+
+// LeechAction defines what happens to a card when it becomes a leech.
+type LeechAction string
+
+const (
+	// LeechActionTag marks the card as a leech and keeps it in the reviews.
+	LeechActionTag LeechAction = "tag"
+	// LeechActionSuspend marks the card as a leech and suspends it.
+	LeechActionSuspend LeechAction = "suspend"
+)
+
+// DefaultSettings returns the settings used by decks that do not define them.
+func DefaultSettings() Settings {
+	return Settings{
+		NewPerDay:        DefaultNewPerDay,
+		ReviewsPerDay:    DefaultReviewsPerDay,
+		DayStartsAt:      DefaultDayStartsAt,
+		LeechThreshold:   DefaultLeechThreshold,
+		LeechAction:      LeechActionTag,
+		LearningSteps:    []int{1, 10},
+		RelearningSteps:  []int{10},
+		MaxAnswerSeconds: DefaultMaxAnswerSeconds,
+	}
+}
+
+// Settings holds the study options of a deck.
+type Settings struct {
+	// NewPerDay is the maximum number of new cards introduced per day.
+	NewPerDay int `json:"new_per_day" validate:"gte=0"`
+	// ReviewsPerDay is the maximum number of review cards shown per day.
+	ReviewsPerDay int `json:"reviews_per_day" validate:"gte=0"`
+	// DayStartsAt is the hour, from 0 to 23, when a new study day begins.
+	DayStartsAt int `json:"day_starts_at" validate:"gte=0,lte=23"`
+	// Timezone is the IANA name of the time zone used to split the study days.
+	// When empty the time zone of the clock is used.
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"`
+	// LeechThreshold is the number of lapses that turns a card into a leech, zero disables the detection.
+	LeechThreshold int `json:"leech_threshold" validate:"gte=0"`
+	// LeechAction is what happens to a card when it becomes a leech.
+	LeechAction LeechAction `json:"leech_action" validate:"oneof=tag suspend"`
+	// LoadBalance spreads the reviews across the days allowed by the interval fuzz
+	// to avoid days with many more reviews than the others.
+	LoadBalance bool `json:"load_balance,omitempty"`
+	// ReviewOrder is the order the cards are shown in the reviews, random when empty.
+	ReviewOrder ReviewOrder `json:"review_order,omitempty" validate:"omitempty,oneof=random due retrievability difficulty new_first new_last interleaved"`
+	// LearningSteps are the minutes between the answers of a new card before it graduates to review.
+	LearningSteps []int `json:"learning_steps" validate:"dive,gt=0"`
+	// RelearningSteps are the minutes between the answers of a forgotten card before it returns to review.
+	RelearningSteps []int `json:"relearning_steps" validate:"dive,gt=0"`
+	// MaxAnswerSeconds caps the time recorded for an answer, so a break in the middle of a card
+	// does not count as study time. Zero means no limit.
+	MaxAnswerSeconds int `json:"max_answer_seconds" validate:"gte=0"`
+	// IgnoreCase compares the typed answers regardless of upper and lower case.
+	IgnoreCase bool `json:"ignore_case,omitempty"`
+	// IgnoreWhitespace compares the typed answers regardless of repeated, leading and trailing spaces.
+	IgnoreWhitespace bool `json:"ignore_whitespace,omitempty"`
+	// IgnoreAccents compares the typed answers regardless of accents and other diacritics.
+	IgnoreAccents bool `json:"ignore_accents,omitempty"`
+}
+
+// minutes converts the steps in minutes to durations.
+func minutes(steps []int) []time.Duration {
+	durations := make([]time.Duration, 0, len(steps))
+	for _, step := range steps {
+		durations = append(durations, time.Duration(step)*time.Minute)
+	}
+	return durations
+}
+
+// IsLeech reports whether a card that has just lapsed for the given time should be handled as a leech.
+// It happens when the card reaches the threshold and again every half threshold after that.
+func (s Settings) IsLeech(lapses uint64) bool {
+	if s.LeechThreshold <= 0 || lapses < uint64(s.LeechThreshold) {
+		return false
+	}
+
+	return (lapses-uint64(s.LeechThreshold))%uint64(max(1, s.LeechThreshold/2)) == 0
+}
+
+// Location returns the time zone of the settings, or the local one when it is not set or unknown.
+func (s Settings) Location() *time.Location {
+	if s.Timezone == "" {
+		return time.Local
+	}
+
+	location, err := loadLocation(s.Timezone)
+	if err != nil {
+		return time.Local
+	}
+
+	return location
+}
+
+// In returns t in the time zone of the settings.
+func (s Settings) In(t time.Time) time.Time {
+	if s.Timezone == "" {
+		return t
+	}
+
+	location, err := loadLocation(s.Timezone)
+	if err != nil {
+		return t
+	}
+
+	return t.In(location)
+}
+
+// StudyDay returns the instant when the study day containing t has started.
+func (s Settings) StudyDay(t time.Time) time.Time {
+	t = s.In(t)
+	shifted := t.Add(-time.Duration(s.DayStartsAt) * time.Hour)
+	return time.Date(shifted.Year(), shifted.Month(), shifted.Day(), s.DayStartsAt, 0, 0, 0, t.Location())
+}
+
+// SameStudyDay reports whether a and b belong to the same study day.
+func (s Settings) SameStudyDay(a, b time.Time) bool {
+	return s.StudyDay(a).Equal(s.StudyDay(b))
+}
+
+// locations caches the loaded time zones because time.LoadLocation reads them from disk.
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+
+	locations.Store(name, location)
+	return location, nil
+}
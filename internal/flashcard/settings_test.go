@@ -0,0 +1,100 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestSettings_StudyDay(t *testing.T) {
+	t.Parallel()
+
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		settings flashcard.Settings
+		args     time.Time
+		want     time.Time
+	}{
+		{
+			name:     "starts today after the day boundary",
+			settings: flashcard.Settings{DayStartsAt: 4},
+			args:     time.Date(2021, 1, 10, 15, 0, 0, 0, time.UTC),
+			want:     time.Date(2021, 1, 10, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "started yesterday before the day boundary",
+			settings: flashcard.Settings{DayStartsAt: 4},
+			args:     time.Date(2021, 1, 10, 3, 59, 0, 0, time.UTC),
+			want:     time.Date(2021, 1, 9, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "starts at midnight",
+			settings: flashcard.Settings{},
+			args:     time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			want:     time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "uses the configured time zone",
+			settings: flashcard.Settings{DayStartsAt: 4, Timezone: "America/Sao_Paulo"},
+			args:     time.Date(2021, 1, 10, 5, 0, 0, 0, time.UTC),
+			want:     time.Date(2021, 1, 9, 4, 0, 0, 0, saoPaulo),
+		},
+		{
+			name:     "ignores an unknown time zone",
+			settings: flashcard.Settings{DayStartsAt: 4, Timezone: "Nowhere/Nothing"},
+			args:     time.Date(2021, 1, 10, 5, 0, 0, 0, time.UTC),
+			want:     time.Date(2021, 1, 10, 4, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.settings.StudyDay(tt.args)
+
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestSettings_SameStudyDay(t *testing.T) {
+	t.Parallel()
+
+	settings := flashcard.Settings{DayStartsAt: 4}
+	evening := time.Date(2021, 1, 10, 22, 0, 0, 0, time.UTC)
+
+	assert.True(t, settings.SameStudyDay(evening, evening.Add(5*time.Hour)))
+	assert.False(t, settings.SameStudyDay(evening, evening.Add(7*time.Hour)))
+	assert.False(t, settings.SameStudyDay(evening, evening.Add(-19*time.Hour)))
+}
+
+func TestSettings_IsLeech(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		threshold int
+		lapses    uint64
+		want      bool
+	}{
+		{name: "below the threshold", threshold: 8, lapses: 7, want: false},
+		{name: "reaches the threshold", threshold: 8, lapses: 8, want: true},
+		{name: "between the half thresholds", threshold: 8, lapses: 10, want: false},
+		{name: "every half threshold after", threshold: 8, lapses: 12, want: true},
+		{name: "threshold of one", threshold: 1, lapses: 3, want: true},
+		{name: "disabled", threshold: 0, lapses: 8, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := flashcard.Settings{LeechThreshold: tt.threshold}
+
+			assert.Equal(t, tt.want, settings.IsLeech(tt.lapses))
+		})
+	}
+}
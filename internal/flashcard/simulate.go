@@ -0,0 +1,151 @@
+package flashcard
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+)
+
+// ErrInvalidSimulation is returned by Simulate when the options are out of range.
+var ErrInvalidSimulation = errors.New("invalid simulation")
+
+// DefaultSimulationDays is the number of days simulated when no other is given.
+const DefaultSimulationDays = 30
+
+// SimulationOptions configures a simulation of the reviews of a deck.
+type SimulationOptions struct {
+	// Days is the number of study days simulated, starting today.
+	Days int
+	// DesiredRetention is the recall probability the scheduler aims at, between zero and one exclusive.
+	DesiredRetention float64
+	// NewPerDay is the number of new cards introduced each day.
+	NewPerDay int
+	// Seed makes the answers drawn reproducible.
+	Seed int64
+}
+
+// DefaultSimulationOptions returns the options that simulate the deck as it is scheduled today.
+func (d Deck) DefaultSimulationOptions() SimulationOptions {
+	return SimulationOptions{
+		Days:             DefaultSimulationDays,
+		DesiredRetention: fsrs.DefaultParam().RequestRetention,
+		NewPerDay:        d.Settings.NewPerDay,
+		Seed:             1,
+	}
+}
+
+// SimulatedDay is the outcome of a study day in a simulation.
+type SimulatedDay struct {
+	Date    time.Time
+	Reviews int
+	New     int
+	// Retention is the expected share of the reviews of the day recalled, zero without reviews.
+	Retention float64
+}
+
+// Simulation is the forecast of the reviews of a deck.
+type Simulation struct {
+	Days []SimulatedDay
+}
+
+// Reviews returns the number of review cards answered in the simulation.
+func (s Simulation) Reviews() int {
+	var total int
+	for _, day := range s.Days {
+		total += day.Reviews
+	}
+	return total
+}
+
+// Retention returns the expected share of all the reviews recalled, zero without reviews.
+func (s Simulation) Retention() float64 {
+	var recalled float64
+	for _, day := range s.Days {
+		recalled += day.Retention * float64(day.Reviews)
+	}
+	if reviews := s.Reviews(); reviews > 0 {
+		return recalled / float64(reviews)
+	}
+	return 0
+}
+
+// Simulate forecasts the reviews of the next days from the current state of the cards.
+// Every day the due cards are answered, within the daily review limit, recalling each card
+// with the probability predicted by FSRS, and the new cards are introduced up to NewPerDay.
+// The learning steps are left out, so the cards are scheduled in days from the first answer.
+func (d Deck) Simulate(opts SimulationOptions) (Simulation, error) {
+	if opts.Days <= 0 || opts.DesiredRetention <= 0 || opts.DesiredRetention >= 1 || opts.NewPerDay < 0 {
+		return Simulation{}, ErrInvalidSimulation
+	}
+
+	params := fsrs.DefaultParam()
+	params.RequestRetention = opts.DesiredRetention
+	params.EnableShortTerm = false
+	scheduler := NewScheduler(params)
+	random := rand.New(rand.NewSource(opts.Seed))
+
+	var reviewing, waiting []Card
+	for _, card := range d.List() {
+		switch {
+		case card.Suspended:
+		case card.State == fsrs.New:
+			waiting = append(waiting, card)
+		default:
+			reviewing = append(reviewing, card)
+		}
+	}
+
+	now := d.clock.Now()
+	today := d.Settings.StudyDay(now)
+	simulation := Simulation{Days: make([]SimulatedDay, 0, opts.Days)}
+	for i := range opts.Days {
+		start := today.AddDate(0, 0, i)
+		end := today.AddDate(0, 0, i+1)
+		ts := start
+		if now.After(start) {
+			ts = now
+		}
+		day := SimulatedDay{Date: start}
+
+		sort.SliceStable(reviewing, func(a, b int) bool { return reviewing[a].Due.Before(reviewing[b].Due) })
+		var recalled float64
+		for j, card := range reviewing {
+			if !card.Due.Before(end) || day.Reviews == d.Settings.ReviewsPerDay {
+				break
+			}
+
+			retrievability := scheduler.GetRetrievability(card, ts)
+			rating := fsrs.Again
+			if random.Float64() < retrievability {
+				rating = fsrs.Good
+			}
+			reviewing[j] = simulateAnswer(scheduler, card, ts, rating)
+			recalled += retrievability
+			day.Reviews++
+		}
+		if day.Reviews > 0 {
+			day.Retention = recalled / float64(day.Reviews)
+		}
+
+		for len(waiting) > 0 && day.New < opts.NewPerDay {
+			reviewing = append(reviewing, simulateAnswer(scheduler, waiting[0], ts, fsrs.Good))
+			waiting = waiting[1:]
+			day.New++
+		}
+
+		simulation.Days = append(simulation.Days, day)
+	}
+
+	return simulation, nil
+}
+
+// simulateAnswer schedules the card without keeping its stats, which the simulation does not need.
+func simulateAnswer(scheduler *Scheduler, card Card, now time.Time, rating fsrs.Rating) Card {
+	card.Stats = nil
+	card = scheduler.ScheduleCard(card, now, rating)
+	card.Stats = nil
+	return card
+}
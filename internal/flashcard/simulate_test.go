@@ -0,0 +1,116 @@
+package flashcard_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestDeck_Simulate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	newSimulationDeck := func(t *testing.T, total int) flashcard.Deck {
+		t.Helper()
+
+		cards := make([]flashcard.Card, 0, total)
+		for i := range total {
+			cards = append(cards, flashcard.NewCard(fmt.Sprintf("question %d", i), "answer", now))
+		}
+		deck, err := flashcard.NewDeck("simulation", testclock.New(now), cards)
+		require.NoError(t, err)
+		return deck
+	}
+
+	t.Run(
+		"introduces the new cards up to the daily limit", func(t *testing.T) {
+			deck := newSimulationDeck(t, 50)
+			opts := deck.DefaultSimulationOptions()
+			opts.Days = 4
+
+			simulation, err := deck.Simulate(opts)
+			require.NoError(t, err)
+
+			require.Len(t, simulation.Days, 4)
+			assert.Equal(t, deck.Settings.StudyDay(now), simulation.Days[0].Date)
+			for i, want := range []int{20, 20, 10, 0} {
+				assert.Equal(t, want, simulation.Days[i].New, "day %d", i)
+			}
+			assert.Zero(t, simulation.Days[0].Reviews)
+		},
+	)
+
+	t.Run(
+		"reviews the due cards within the daily limit", func(t *testing.T) {
+			cards := make([]flashcard.Card, 0, 30)
+			for i := range 30 {
+				cards = append(cards, newReviewCard(fmt.Sprintf("question %d", i), 10, now.AddDate(0, 0, -10), now))
+			}
+			deck, err := flashcard.NewDeck("simulation", testclock.New(now), cards)
+			require.NoError(t, err)
+			deck.Settings.ReviewsPerDay = 20
+
+			simulation, err := deck.Simulate(deck.DefaultSimulationOptions())
+			require.NoError(t, err)
+
+			assert.Equal(t, 20, simulation.Days[0].Reviews)
+			assert.Equal(t, 10, simulation.Days[1].Reviews)
+			assert.InDelta(t, 0.9, simulation.Days[0].Retention, 0.05)
+		},
+	)
+
+	t.Run(
+		"costs more reviews for a higher retention", func(t *testing.T) {
+			deck := newSimulationDeck(t, 300)
+			opts := deck.DefaultSimulationOptions()
+			opts.Days = 90
+
+			lower, err := deck.Simulate(opts)
+			require.NoError(t, err)
+
+			opts.DesiredRetention = 0.95
+			higher, err := deck.Simulate(opts)
+			require.NoError(t, err)
+
+			assert.Greater(t, higher.Reviews(), lower.Reviews())
+			assert.Greater(t, higher.Retention(), lower.Retention())
+		},
+	)
+
+	t.Run(
+		"is reproducible with the same seed", func(t *testing.T) {
+			deck := newSimulationDeck(t, 100)
+			opts := deck.DefaultSimulationOptions()
+
+			first, err := deck.Simulate(opts)
+			require.NoError(t, err)
+			second, err := deck.Simulate(opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, first, second)
+		},
+	)
+
+	t.Run(
+		"returns error when the options are invalid", func(t *testing.T) {
+			deck := newSimulationDeck(t, 1)
+
+			for _, opts := range []flashcard.SimulationOptions{
+				{Days: 0, DesiredRetention: 0.9},
+				{Days: 10, DesiredRetention: 1},
+				{Days: 10, DesiredRetention: 0},
+				{Days: 10, DesiredRetention: 0.9, NewPerDay: -1},
+			} {
+				_, err := deck.Simulate(opts)
+				assert.ErrorIs(t, err, flashcard.ErrInvalidSimulation, "%+v", opts)
+			}
+		},
+	)
+}
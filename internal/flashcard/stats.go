@@ -17,6 +17,10 @@ type Stats struct {
 	Lapses        uint64      `json:"lapses"`
 	State         fsrs.State  `json:"state"`
 	LastReview    time.Time   `json:"last_review"`
+	// Duration is the time taken to answer, from the question shown to the rating.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Hints is the number of hints revealed before answering.
+	Hints int `json:"hints,omitempty"`
 }
 
 // NewStats creates stats using FSRS data.
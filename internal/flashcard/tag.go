@@ -0,0 +1,135 @@
+package flashcard
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+)
+
+// ParseTags splits the text in tags separated by spaces or commas, leaving out the repeated ones.
+// The tags cannot have spaces, so they can be written in the queries as tag:name.
+func ParseTags(text string) []string {
+	var tags []string
+	fields := strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' || r == '\t' })
+	for _, tag := range fields {
+		if !slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// HasTag reports whether the card is tagged with the tag, ignoring the case.
+func (c Card) HasTag(tag string) bool {
+	return slices.ContainsFunc(c.Tags, func(t string) bool { return strings.EqualFold(t, tag) })
+}
+
+// Tags returns the tags of the cards of the deck sorted by name.
+func (d Deck) Tags() []string {
+	var tags []string
+	for _, card := range d.Cards {
+		for _, tag := range card.Tags {
+			if !slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	slices.SortFunc(tags, func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) })
+	return tags
+}
+
+// SetTags replaces the tags of the card, and of the other cards of its note.
+func (d Deck) SetTags(card Card, tags []string) (Deck, Card) {
+	tags = ParseTags(strings.Join(tags, " "))
+	for _, c := range d.Siblings(card) {
+		c.Tags = tags
+		d = d.Change(c)
+	}
+	card.Tags = tags
+	return d, card
+}
+
+// AddTags tags the cards, and the other cards of their notes, returning how many cards changed.
+func (d Deck) AddTags(cards []Card, tags []string) (Deck, int) {
+	return d.retag(cards, func(card Card) []string { return ParseTags(strings.Join(slices.Concat(card.Tags, tags), " ")) })
+}
+
+// RemoveTags untags the cards, and the other cards of their notes, returning how many cards changed.
+func (d Deck) RemoveTags(cards []Card, tags []string) (Deck, int) {
+	return d.retag(cards, func(card Card) []string {
+		return slices.DeleteFunc(slices.Clone(card.Tags), func(t string) bool {
+			return slices.ContainsFunc(tags, func(tag string) bool { return strings.EqualFold(t, tag) })
+		})
+	})
+}
+
+func (d Deck) retag(cards []Card, tags func(Card) []string) (Deck, int) {
+	ids, notes := make(map[string]bool), make(map[string]bool)
+	for _, card := range cards {
+		ids[card.ID] = true
+		if card.Note != "" {
+			notes[card.Note] = true
+		}
+	}
+
+	var changed int
+	for _, c := range d.Cards {
+		if !ids[c.ID] && !notes[c.Note] {
+			continue
+		}
+		if updated := tags(c); !slices.Equal(c.Tags, updated) {
+			c.Tags = updated
+			d = d.Change(c)
+			changed++
+		}
+	}
+	return d, changed
+}
+
+// TagStats summarizes the cards sharing a tag.
+type TagStats struct {
+	Tag   string
+	Cards int
+	// Due is the number of cards left to study today, within the daily limits of the deck.
+	Due     int
+	Reviews int
+	// Retention is the share of the reviews answered other than again, zero without reviews.
+	Retention float64
+}
+
+// TagStats returns the summary of each tag of the deck, sorted by tag name.
+func (d Deck) TagStats() []TagStats {
+	due := make(map[string]bool)
+	for _, card := range d.DueCards() {
+		due[card.ID] = true
+	}
+
+	tags := d.Tags()
+	stats := make([]TagStats, 0, len(tags))
+	for _, tag := range tags {
+		summary := TagStats{Tag: tag}
+		var recalled int
+		for _, card := range d.Cards {
+			if !card.HasTag(tag) {
+				continue
+			}
+
+			summary.Cards++
+			if due[card.ID] {
+				summary.Due++
+			}
+			for _, s := range card.Stats {
+				summary.Reviews++
+				if s.Rating != fsrs.Again {
+					recalled++
+				}
+			}
+		}
+		if summary.Reviews > 0 {
+			summary.Retention = float64(recalled) / float64(summary.Reviews)
+		}
+		stats = append(stats, summary)
+	}
+	return stats
+}
@@ -0,0 +1,123 @@
+package flashcard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testclock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: " ", want: nil},
+		{name: "separated by spaces", text: "networking linux", want: []string{"networking", "linux"}},
+		{name: "separated by commas", text: "networking, linux,tcp", want: []string{"networking", "linux", "tcp"}},
+		{name: "repeated", text: "networking Networking linux", want: []string{"networking", "linux"}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, flashcard.ParseTags(tt.text))
+			},
+		)
+	}
+}
+
+func newTagDeck(t *testing.T, now time.Time) flashcard.Deck {
+	t.Helper()
+
+	tcp := flashcard.NewCard("tcp", "transmission control protocol", now)
+	tcp.Tags = []string{"networking"}
+	ls := flashcard.NewCard("ls", "list directory contents", now)
+	ls.Tags = []string{"linux", "Shell"}
+	deck, err := flashcard.NewDeck("tags", testclock.New(now), []flashcard.Card{tcp, ls})
+	require.NoError(t, err)
+	return deck
+}
+
+func TestDeck_Tags(t *testing.T) {
+	t.Parallel()
+
+	deck := newTagDeck(t, time.Now())
+
+	assert.Equal(t, []string{"linux", "networking", "Shell"}, deck.Tags())
+	assert.True(t, deck.Cards[1].HasTag("shell"))
+	assert.False(t, deck.Cards[0].HasTag("shell"))
+}
+
+func TestDeck_SetTags(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	deck, card, reverse := newTagDeck(t, now).AddReversible("dog", "cachorro")
+
+	deck, card = deck.SetTags(card, []string{"animals", "Animals", "portuguese"})
+
+	assert.Equal(t, []string{"animals", "portuguese"}, card.Tags)
+	assert.Equal(t, card.Tags, getCard(deck, card.ID).Tags)
+	assert.Equal(t, card.Tags, getCard(deck, reverse.ID).Tags)
+	assert.Equal(t, []string{"networking"}, deck.Cards[0].Tags)
+}
+
+func TestDeck_AddTags(t *testing.T) {
+	t.Parallel()
+
+	deck := newTagDeck(t, time.Now())
+
+	deck, changed := deck.AddTags(deck.Cards, []string{"linux", "review"})
+
+	assert.Equal(t, 2, changed)
+	assert.Equal(t, []string{"networking", "linux", "review"}, deck.Cards[0].Tags)
+	assert.Equal(t, []string{"linux", "Shell", "review"}, deck.Cards[1].Tags)
+
+	_, changed = deck.AddTags(deck.Cards, []string{"review"})
+	assert.Zero(t, changed)
+}
+
+func TestDeck_RemoveTags(t *testing.T) {
+	t.Parallel()
+
+	deck := newTagDeck(t, time.Now())
+	cards := deck.Cards
+	deck, _ = deck.AddTags(cards, []string{"review"})
+
+	deck, changed := deck.RemoveTags(cards, []string{"shell", "unknown"})
+
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, []string{"networking", "review"}, deck.Cards[0].Tags)
+	assert.Equal(t, []string{"linux", "review"}, deck.Cards[1].Tags)
+}
+
+func TestDeck_TagStats(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC)
+	c := testclock.New(now)
+	deck := newTagDeck(t, now)
+
+	review := flashcard.NewReview(deck, c, flashcard.WithFilter(flashcard.Filter{Query: "tag:networking"}))
+	review, err := review.Rate(flashcard.ReviewScoreAgain)
+	require.NoError(t, err)
+	review, err = review.Rate(flashcard.ReviewScoreGood)
+	require.NoError(t, err)
+
+	stats := review.Deck.TagStats()
+
+	assert.Equal(
+		t, []flashcard.TagStats{
+			{Tag: "linux", Cards: 1, Due: 1},
+			{Tag: "networking", Cards: 1, Reviews: 2, Retention: 0.5},
+			{Tag: "Shell", Cards: 1, Due: 1},
+		}, stats,
+	)
+}
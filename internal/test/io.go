@@ -14,22 +14,11 @@ func TempCopyDir(t *testing.T, source string) string {
 		t.Fatal(err)
 	}
 
-	files, err := os.ReadDir(source)
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	destination := t.TempDir()
 
-	for _, file := range files {
-		data, err := os.ReadFile(filepath.Join(source, file.Name()))
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		if err := os.WriteFile(filepath.Join(destination, file.Name()), data, 0o644); err != nil {
-			t.Fatal(err)
-		}
+	// the directories, like the media of the decks, are copied along with the files.
+	if err := os.CopyFS(destination, os.DirFS(source)); err != nil {
+		t.Fatal(err)
 	}
 
 	return destination
@@ -2,6 +2,8 @@ package tui
 
 import (
 	"log"
+	"os"
+	"slices"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -34,6 +36,23 @@ func WithWindowSize(width, height int) ModelOption {
 	}
 }
 
+// WithGoal ends every review once the goal is reached.
+func WithGoal(goal flashcard.Goal) ModelOption {
+	return func(m *Model) {
+		m.goal = goal
+	}
+}
+
+// WithImages draws the images of the cards with the protocol, detecting the one supported by the terminal when auto.
+func WithImages(protocol ImageProtocol) ModelOption {
+	return func(m *Model) {
+		if protocol == ImagesAuto {
+			protocol = DetectImageProtocol(os.Getenv)
+		}
+		m.images = protocol
+	}
+}
+
 // Repository wraps the file system operation
 // to be easier and quicker run the tests.
 type Repository interface {
@@ -84,6 +103,8 @@ type Shared struct {
 	height     int
 	styles     *Styles
 	debug      bool
+	goal       flashcard.Goal
+	images     ImageProtocol
 }
 
 func (s *Shared) Log(msg string, v ...any) {
@@ -129,6 +150,22 @@ type setCardsPageMsg struct {
 	deck  flashcard.Deck
 }
 
+func showFilters(deck flashcard.Deck) tea.Cmd {
+	return func() tea.Msg {
+		return setFiltersPageMsg{deck: deck}
+	}
+}
+
+type setFiltersPageMsg struct {
+	deck flashcard.Deck
+}
+
+func showLeeches() tea.Msg {
+	return setLeechesPageMsg{}
+}
+
+type setLeechesPageMsg struct{}
+
 func showStats(index int, card flashcard.Card, deck flashcard.Deck) tea.Cmd {
 	return func() tea.Msg {
 		return setStatsPageMsg{deck: deck, card: card, cardIndex: index}
@@ -141,14 +178,49 @@ type setStatsPageMsg struct {
 	card      flashcard.Card
 }
 
-func startReview(d flashcard.Deck) tea.Cmd {
+func showSimulation(index int, card flashcard.Card, deck flashcard.Deck) tea.Cmd {
+	return func() tea.Msg {
+		return setSimulationPageMsg{deck: deck, card: card, cardIndex: index}
+	}
+}
+
+// setSimulationPageMsg opens the forecast of the deck from the stats of the card.
+type setSimulationPageMsg struct {
+	cardIndex int
+	deck      flashcard.Deck
+	card      flashcard.Card
+}
+
+func startReview(decks ...flashcard.Deck) tea.Cmd {
 	return func() tea.Msg {
-		return setReviewPageMsg{d}
+		return setReviewPageMsg{decks: decks}
+	}
+}
+
+func startFilteredReview(filter flashcard.Filter, decks ...flashcard.Deck) tea.Cmd {
+	return func() tea.Msg {
+		return setReviewPageMsg{decks: decks, filter: &filter}
 	}
 }
 
 type setReviewPageMsg struct {
-	flashcard.Deck
+	decks []flashcard.Deck
+	// filter studies the cards matching it, a session is resumed only when it was started with the same filter.
+	filter *flashcard.Filter
+	// opts narrow the review further, which a saved session does not keep, so it is not resumed.
+	opts []flashcard.ReviewOption
+	// restart starts a new review even when there is a session to resume.
+	restart bool
+}
+
+func resumeReview(review flashcard.Review) tea.Cmd {
+	return func() tea.Msg {
+		return resumeReviewMsg{review}
+	}
+}
+
+type resumeReviewMsg struct {
+	review flashcard.Review
 }
 
 type setQuitPageMsg struct{}
@@ -193,15 +265,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.page.Init()
 
 	case setCardsPageMsg:
-		m.page = newCardPage(m.Shared, msg.deck)
+		m.page = newCardPage(m.Shared, msg.deck, msg.index)
+		return m, m.page.Init()
+
+	case setFiltersPageMsg:
+		m.page = newFilterPage(m.Shared, msg.deck)
+		return m, m.page.Init()
+
+	case setLeechesPageMsg:
+		m.page = newLeechPage(m.Shared)
 		return m, m.page.Init()
 
 	case setStatsPageMsg:
 		m.page = newStatsModel(m.Shared, msg)
 		return m, m.page.Init()
 
+	case setSimulationPageMsg:
+		m.page = newSimulationPage(m.Shared, msg)
+		return m, m.page.Init()
+
 	case setReviewPageMsg:
-		m.page = newReviewPage(m.Shared, flashcard.NewReview(msg.Deck, m.clock))
+		goal := flashcard.WithGoal(m.goal)
+		if review, ok := resumable(msg.decks, msg.filter, m.clock, goal); ok && !msg.restart && len(msg.opts) == 0 {
+			m.page = newResumePage(m.Shared, review, msg)
+			return m, m.page.Init()
+		}
+
+		opts := append(slices.Clone(msg.opts), goal)
+		if msg.filter != nil {
+			opts = append(opts, flashcard.WithFilter(*msg.filter))
+		}
+		m.page = newReviewPage(m.Shared, flashcard.NewCrossDeckReview(msg.decks, m.clock, opts...))
+		return m, m.page.Init()
+
+	case resumeReviewMsg:
+		m.page = newReviewPage(m.Shared, msg.review)
 		return m, m.page.Init()
 
 	case setErrorPageMsg:
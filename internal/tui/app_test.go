@@ -43,6 +43,12 @@ const (
 	emptyDeck      = "./testdata/empty"
 	noneDeck       = "./testdata/none"
 	longNamesDeck  = "./testdata/long"
+	leechDeck      = "./testdata/leech"
+	notesDeck      = "./testdata/notes"
+	tagsDeck       = "./testdata/tags"
+	mediaDeck      = "./testdata/media"
+	hintsDeck      = "./testdata/hints"
+	flagsDeck      = "./testdata/flags"
 	errorDeckName  = "Error"
 
 	createKey    = "a"
@@ -57,6 +63,19 @@ const (
 	editKey      = "e"
 	helpKey      = "?"
 	filterKey    = "/"
+	suspendKey   = "u"
+	leechesKey   = "L"
+	buryKey      = "b"
+	filtersKey   = "F"
+	cramKey      = "ctrl+r"
+	reverseKey   = "ctrl+r"
+	noteTypeKey  = "ctrl+t"
+	typeKey      = "ctrl+y"
+	choicesKey   = "ctrl+o"
+	tagKey       = "t"
+	hintKey      = "h"
+	flagKey      = "f"
+	flagFormKey  = "ctrl+g"
 	activePrompt = "│ "
 )
 
@@ -2,13 +2,17 @@ package tui
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/open-spaced-repetition/go-fsrs/v3"
 
 	"github.com/eliostvs/lembrol/internal/clock"
 	"github.com/eliostvs/lembrol/internal/flashcard"
@@ -34,10 +38,8 @@ type (
 		list list.Model
 	}
 
-	cardCreatedMsg struct {
+	showTagCardsMsg struct {
 		list list.Model
-		card flashcard.Card
-		deck flashcard.Deck
 	}
 
 	cardDeletedMsg struct {
@@ -45,11 +47,22 @@ type (
 		deck flashcard.Deck
 	}
 
-	cardChangedMsg struct {
+	cardRescheduledMsg struct {
 		list list.Model
 		card flashcard.Card
 		deck flashcard.Deck
 	}
+
+	cardsSavedMsg struct {
+		list list.Model
+		card flashcard.Card
+		deck flashcard.Deck
+	}
+
+	cardsTaggedMsg struct {
+		list list.Model
+		deck flashcard.Deck
+	}
 )
 
 func showBrowseCard(model list.Model) tea.Cmd {
@@ -76,23 +89,182 @@ func showDeleteCard(model list.Model) tea.Cmd {
 	}
 }
 
-func createCard(question, answer string, shared cardShared) tea.Cmd {
+func showTagCards(model list.Model) tea.Cmd {
+	return func() tea.Msg {
+		return showTagCardsMsg{list: model}
+	}
+}
+
+// cardOptions are the options of the card form.
+type cardOptions struct {
+	reversible bool
+	// typeAnswer applies to all the cards of the note.
+	typeAnswer bool
+	// multipleChoice applies to the card only, its reverse has other answers to choose from.
+	multipleChoice bool
+	// tags applies to all the cards of the note.
+	tags []string
+	// hints applies to the card only, each card of a note asks something else.
+	hints []string
+	// flag and notes apply to the card only.
+	flag  flashcard.Flag
+	notes string
+}
+
+const (
+	// distractorsField is the field of the form with the wrong choices of the multiple choice cards, one per line.
+	distractorsField = "Distractors"
+	// tagsField is the field of the form with the tags of the note, separated by spaces or commas.
+	tagsField = "Tags"
+	// hintsField is the field of the form with the hints of the card, one per line.
+	hintsField = "Hints"
+	// notesField is the field of the form with the personal notes of the card.
+	notesField = "Notes"
+)
+
+func createCard(noteType string, values map[string]string, options cardOptions, shared cardShared) tea.Cmd {
 	return func() tea.Msg {
+		if noteType != flashcard.BasicNoteType {
+			deck, cards, err := shared.deck.AddNote(noteType, values)
+			if err != nil {
+				return fail(err)
+			}
+			return saveCards(deck, cards[0], options, shared)
+		}
+
+		question, answer := values["Question"], values["Answer"]
+		if flashcard.HasCloze(question) {
+			deck, cards, err := shared.deck.AddCloze(question, answer)
+			if err != nil {
+				return fail(err)
+			}
+			return saveCards(deck, cards[0], options, shared)
+		}
+
+		distractors := strings.Split(values[distractorsField], "\n")
+		if options.reversible {
+			deck, card, _ := shared.deck.AddReversible(question, answer)
+			deck, card = deck.SetChoices(card, options.multipleChoice, distractors)
+			return saveCards(deck, card, options, shared)
+		}
+
 		deck, card := shared.deck.Add(question, answer)
+		deck, card = deck.SetChoices(card, options.multipleChoice, distractors)
+		return saveCards(deck, card, options, shared)
+	}
+}
+
+func updateCard(card flashcard.Card, question, answer string, options cardOptions, shared cardShared) tea.Cmd {
+	return func() tea.Msg {
+		if card.IsCloze() || flashcard.HasCloze(question) {
+			deck, cards, err := shared.deck.ChangeCloze(card, question, answer)
+			if err != nil {
+				return fail(err)
+			}
+			return saveCards(deck, editedCard(cards, card), options, shared)
+		}
+
+		deck, card := shared.deck.Rewrite(card, question, answer)
+		if _, ok := deck.Reverse(card); ok || options.reversible {
+			deck, card = deck.ChangeReversible(card, options.reversible)
+		}
+		deck, card = deck.SetChoices(card, options.multipleChoice, card.Distractors)
+		return saveCards(deck, card, options, shared)
+	}
+}
+
+func updateNote(card flashcard.Card, values map[string]string, options cardOptions, shared cardShared) tea.Cmd {
+	return func() tea.Msg {
+		deck, cards, err := shared.deck.ChangeNote(card, values)
+		if err != nil {
+			return fail(err)
+		}
+		return saveCards(deck, editedCard(cards, card), options, shared)
+	}
+}
+
+// editedCard returns the card being edited among the cards of its note after the change,
+// or the first one when the change removed it.
+func editedCard(cards []flashcard.Card, card flashcard.Card) flashcard.Card {
+	if i := slices.IndexFunc(cards, func(c flashcard.Card) bool { return c.ID == card.ID }); i >= 0 {
+		return cards[i]
+	}
+	return cards[0]
+}
+
+// saveCards saves the card, or the cards of its note which may be many at once, and selects the given one.
+func saveCards(deck flashcard.Deck, card flashcard.Card, options cardOptions, shared cardShared) tea.Msg {
+	deck, card = deck.SetTypeAnswer(card, options.typeAnswer)
+	deck, card = deck.SetTags(card, options.tags)
+	deck, card = deck.SetHints(card, options.hints)
+	deck, card = deck.SetFlag(card, options.flag)
+	deck, card = deck.SetNotes(card, options.notes)
+	if err := shared.repository.Save(deck); err != nil {
+		return fail(err)
+	}
+	return cardsSavedMsg{list: shared.list, deck: deck, card: card}
+}
+
+func toggleSuspended(card flashcard.Card, shared cardShared) tea.Cmd {
+	return func() tea.Msg {
+		var deck flashcard.Deck
+		if card.Suspended {
+			deck, card = shared.deck.Unsuspend(card)
+		} else {
+			deck, card = shared.deck.Suspend(card)
+		}
+
+		if err := shared.repository.Save(deck); err != nil {
+			return fail(err)
+		}
+		return cardRescheduledMsg{list: shared.list, deck: deck, card: card}
+	}
+}
+
+func toggleBuried(card flashcard.Card, shared cardShared) tea.Cmd {
+	return func() tea.Msg {
+		var deck flashcard.Deck
+		if card.IsBuried(shared.clock.Now()) {
+			deck, card = shared.deck.Unbury(card)
+		} else {
+			deck, card = shared.deck.Bury(card)
+		}
+
+		if err := shared.repository.Save(deck); err != nil {
+			return fail(err)
+		}
+		return cardRescheduledMsg{list: shared.list, deck: deck, card: card}
+	}
+}
+
+func forgetCard(card flashcard.Card, shared cardShared) tea.Cmd {
+	return func() tea.Msg {
+		deck, card := shared.deck.Forget(card, true)
 		if err := shared.repository.Save(deck); err != nil {
 			return fail(err)
 		}
-		return cardCreatedMsg{list: shared.list, card: card, deck: deck}
+		return cardRescheduledMsg{list: shared.list, deck: deck, card: card}
 	}
 }
 
-func updateCard(card flashcard.Card, shared cardShared) tea.Cmd {
+// tagCards adds the tags to the cards, and removes the ones prefixed with -.
+func tagCards(cards []flashcard.Card, tags []string, shared cardShared) tea.Cmd {
 	return func() tea.Msg {
-		deck := shared.deck.Change(card)
+		var added, removed []string
+		for _, tag := range tags {
+			if name, ok := strings.CutPrefix(tag, "-"); ok {
+				removed = append(removed, name)
+			} else {
+				added = append(added, tag)
+			}
+		}
+
+		deck, _ := shared.deck.AddTags(cards, added)
+		deck, _ = deck.RemoveTags(cards, removed)
 		if err := shared.repository.Save(deck); err != nil {
 			return fail(err)
 		}
-		return cardChangedMsg{list: shared.list, deck: deck, card: card}
+		return cardsTaggedMsg{list: shared.list, deck: deck}
 	}
 }
 
@@ -120,37 +292,192 @@ func currentCard(m list.Model) flashcard.Card {
 	return flashcard.Card{}
 }
 
+// selectedCards returns the cards selected to be tagged together, or the cards shown when none is selected.
+func selectedCards(m list.Model) []flashcard.Card {
+	var selected, visible []flashcard.Card
+	for _, item := range m.Items() {
+		if card := item.(cardItem); card.selected {
+			selected = append(selected, card.Card)
+		}
+	}
+	for _, item := range m.VisibleItems() {
+		visible = append(visible, item.(cardItem).Card)
+	}
+
+	if len(selected) == 0 {
+		return visible
+	}
+	return selected
+}
+
+func isCardSelected(m list.Model) bool {
+	item, ok := m.SelectedItem().(cardItem)
+	return ok && item.selected
+}
+
+func hasCardSelection(m list.Model) bool {
+	for _, item := range m.Items() {
+		if item.(cardItem).selected {
+			return true
+		}
+	}
+	return false
+}
+
 // Card Item
 
 type cardItem struct {
 	flashcard.Card
 	// is used in the render phase to check if the card is due
 	// need to be here because the list.NewDefaultDelegate don't send parameter to the description method
-	clock clock.Clock
+	clock    clock.Clock
+	settings flashcard.Settings
+	selected bool
 }
 
 func (c cardItem) Title() string {
-	return c.Question
+	if c.selected {
+		return "✓ " + c.Front()
+	}
+	return c.Front()
 }
 
 func (c cardItem) Description() string {
-	var due string
+	var status string
+
+	now := c.clock.Now()
+	switch {
+	case c.Suspended:
+		status = " • suspended"
+	case c.IsBuried(now):
+		status = " • buried"
+	case c.IsDue(now, c.settings):
+		status = " • due"
+	}
 
-	if c.IsDue(c.clock.Now()) {
-		due += " • due"
+	if c.Leech {
+		status += " • leech"
 	}
 
-	return fmt.Sprintf("Last review %s%s", naturalTime(c.LastReview), due)
+	if c.IsCloze() {
+		status += fmt.Sprintf(" • cloze %d", c.Cloze)
+	}
+
+	if c.Reversed {
+		status += " • reversed"
+	}
+
+	if c.Type != "" {
+		status += " • " + c.Type
+	}
+
+	for _, tag := range c.Tags {
+		status += " • #" + tag
+	}
+
+	if c.IsFlagged() {
+		status += " • " + flagView(c.Flag)
+	}
+
+	return fmt.Sprintf("Last review %s%s", naturalTime(c.LastReview), status)
 }
 
+// FilterValue returns the question followed by the card properties, like is:suspended,
+// that can be used to narrow the filter.
 func (c cardItem) FilterValue() string {
-	return c.Question
+	return c.Question + filterSeparator + strings.Join(c.properties(), " ")
 }
 
-func newCardItems(cards []flashcard.Card, clock clock.Clock) []list.Item {
+func (c cardItem) properties() []string {
+	var properties []string
+
+	now := c.clock.Now()
+	if c.Suspended {
+		properties = append(properties, suspendedFilter)
+	}
+	if c.IsBuried(now) {
+		properties = append(properties, buriedFilter)
+	}
+	if !c.Suspended && !c.IsBuried(now) && c.IsDue(now, c.settings) {
+		properties = append(properties, dueFilter)
+	}
+	if c.Leech {
+		properties = append(properties, leechFilter)
+	}
+	for _, tag := range c.Tags {
+		properties = append(properties, tagFilter+strings.ToLower(tag))
+	}
+	if c.IsFlagged() {
+		properties = append(properties, flaggedFilter, flagFilter+string(c.Flag))
+	}
+
+	return properties
+}
+
+const (
+	// filterSeparator splits the question from the card properties in the filter value.
+	filterSeparator = "\x1f"
+	suspendedFilter = "is:suspended"
+	buriedFilter    = "is:buried"
+	dueFilter       = "is:due"
+	leechFilter     = "is:leech"
+	tagFilter       = "tag:"
+	flaggedFilter   = "is:flagged"
+	flagFilter      = "flag:"
+)
+
+// filterCards keeps the cards having all the properties in the term, like is:suspended,
+// and fuzzy matches the remaining words against the card question.
+func filterCards(term string, targets []string) []list.Rank {
+	var properties, words []string
+	for _, field := range strings.Fields(term) {
+		if strings.Contains(field, ":") {
+			properties = append(properties, strings.ToLower(field))
+		} else {
+			words = append(words, field)
+		}
+	}
+
+	questions := make([]string, 0, len(targets))
+	indexes := make([]int, 0, len(targets))
+	for i, target := range targets {
+		question, values, _ := strings.Cut(target, filterSeparator)
+		if !containsAll(strings.Fields(values), properties) {
+			continue
+		}
+		questions = append(questions, question)
+		indexes = append(indexes, i)
+	}
+
+	if len(words) == 0 {
+		ranks := make([]list.Rank, 0, len(indexes))
+		for _, index := range indexes {
+			ranks = append(ranks, list.Rank{Index: index})
+		}
+		return ranks
+	}
+
+	ranks := list.DefaultFilter(strings.Join(words, " "), questions)
+	for i := range ranks {
+		ranks[i].Index = indexes[ranks[i].Index]
+	}
+	return ranks
+}
+
+func containsAll(values, wanted []string) bool {
+	for _, w := range wanted {
+		if !slices.Contains(values, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func newCardItems(deck flashcard.Deck, clock clock.Clock) []list.Item {
+	cards := deck.List()
 	items := make([]list.Item, 0, len(cards))
 	for _, card := range cards {
-		items = append(items, cardItem{Card: card, clock: clock})
+		items = append(items, cardItem{Card: card, clock: clock, settings: deck.Settings})
 	}
 	return items
 }
@@ -158,11 +485,19 @@ func newCardItems(cards []flashcard.Card, clock clock.Clock) []list.Item {
 // Browse Card
 
 type cardBrowseKeyMap struct {
-	add    key.Binding
-	stats  key.Binding
-	study  key.Binding
-	edit   key.Binding
-	delete key.Binding
+	add       key.Binding
+	stats     key.Binding
+	study     key.Binding
+	edit      key.Binding
+	delete    key.Binding
+	suspend   key.Binding
+	bury      key.Binding
+	suspended key.Binding
+	forget    key.Binding
+	filters   key.Binding
+	tag       key.Binding
+	flagged   key.Binding
+	selected  key.Binding
 }
 
 func (k cardBrowseKeyMap) ShortHelp() []key.Binding {
@@ -179,6 +514,14 @@ func (k cardBrowseKeyMap) FullHelp() []key.Binding {
 		k.delete,
 		k.stats,
 		k.study,
+		k.suspend,
+		k.bury,
+		k.suspended,
+		k.forget,
+		k.filters,
+		k.selected,
+		k.tag,
+		k.flagged,
 	}
 }
 
@@ -210,6 +553,38 @@ func newCardBrowsePage(shared cardShared) cardBrowsePage {
 				key.WithKeys("x", "delete"),
 				key.WithHelp("x", "delete"),
 			),
+			suspend: key.NewBinding(
+				key.WithKeys("u"),
+				key.WithHelp("u", "suspend"),
+			),
+			bury: key.NewBinding(
+				key.WithKeys("b"),
+				key.WithHelp("b", "bury"),
+			),
+			suspended: key.NewBinding(
+				key.WithKeys("U"),
+				key.WithHelp("U", "suspended"),
+			),
+			forget: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "reset"),
+			),
+			filters: key.NewBinding(
+				key.WithKeys("F"),
+				key.WithHelp("F", "filters"),
+			),
+			tag: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "tag"),
+			),
+			flagged: key.NewBinding(
+				key.WithKeys("f"),
+				key.WithHelp("f", "flagged"),
+			),
+			selected: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "select"),
+			),
 		},
 	}.checkKeyMap()
 }
@@ -249,14 +624,46 @@ func (m cardBrowsePage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, showEditCard(m.list, currentCard(m.list))
 
 		case key.Matches(msg, m.keyMap.study):
+			if query := tagQuery(m.list); query != "" {
+				return m, startTaggedReview(query, m.deck)
+			}
 			return m, startReview(m.deck)
 
+		case key.Matches(msg, m.keyMap.tag):
+			return m, showTagCards(m.list)
+
+		case key.Matches(msg, m.keyMap.selected):
+			item := m.list.SelectedItem().(cardItem)
+			item.selected = !item.selected
+			cmd = m.list.SetItem(m.list.GlobalIndex(), item)
+			return m.checkKeyMap(), cmd
+
 		case key.Matches(msg, m.keyMap.delete):
 			return m, showDeleteCard(m.list)
 
 		case key.Matches(msg, m.keyMap.stats):
 			return m, showStats(m.list.Index(), currentCard(m.list), m.deck)
 
+		case key.Matches(msg, m.keyMap.suspend):
+			return m, toggleSuspended(currentCard(m.list), m.cardShared)
+
+		case key.Matches(msg, m.keyMap.bury):
+			return m, toggleBuried(currentCard(m.list), m.cardShared)
+
+		case key.Matches(msg, m.keyMap.forget):
+			return m, forgetCard(currentCard(m.list), m.cardShared)
+
+		case key.Matches(msg, m.keyMap.filters):
+			return m, showFilters(m.deck)
+
+		case key.Matches(msg, m.keyMap.suspended):
+			m.list.SetFilterText(suspendedFilter)
+			return m.checkKeyMap(), nil
+
+		case key.Matches(msg, m.keyMap.flagged):
+			m.list.SetFilterText(flaggedFilter)
+			return m.checkKeyMap(), nil
+
 		case key.Matches(msg, m.list.KeyMap.Quit) && m.list.FilterState() != list.FilterApplied:
 			return m, showDecks(0)
 		}
@@ -279,6 +686,22 @@ func (m cardBrowsePage) checkKeyMap() cardBrowsePage {
 	m.keyMap.edit.SetEnabled(hasCards)
 	m.keyMap.stats.SetEnabled(hasCards)
 	m.keyMap.study.SetEnabled(m.deck.HasDueCards())
+	m.keyMap.filters.SetEnabled(hasCards && m.list.FilterState() == list.Unfiltered)
+	m.keyMap.suspend.SetEnabled(hasCards)
+	m.keyMap.bury.SetEnabled(hasCards)
+	m.keyMap.tag.SetEnabled(len(selectedCards(m.list)) > 0)
+	if hasCardSelection(m.list) {
+		m.keyMap.tag.SetHelp("t", "tag selected")
+	} else {
+		m.keyMap.tag.SetHelp("t", "tag")
+	}
+	m.keyMap.selected.SetEnabled(len(m.list.VisibleItems()) > 0)
+	m.keyMap.selected.SetHelp("space", toggleHelp(isCardSelected(m.list), "select"))
+	m.keyMap.forget.SetEnabled(hasCards && currentCard(m.list).State != fsrs.New)
+	m.keyMap.suspended.SetEnabled(hasCards && m.list.FilterState() == list.Unfiltered)
+	m.keyMap.flagged.SetEnabled(hasCards && m.list.FilterState() == list.Unfiltered)
+	m.keyMap.suspend.SetHelp("u", toggleHelp(currentCard(m.list).Suspended, "suspend"))
+	m.keyMap.bury.SetHelp("b", toggleHelp(currentCard(m.list).IsBuried(m.clock.Now()), "bury"))
 	m.list.NewStatusMessage("")
 	m.list.SetFilteringEnabled(hasCards)
 	m.list.SetShowStatusBar(hasCards)
@@ -299,13 +722,56 @@ func (m cardBrowsePage) checkKeyMap() cardBrowsePage {
 	return m
 }
 
+// tagQuery returns the tag terms of the applied filter, like tag:networking, which scope the study
+// of the deck to the cards tagged.
+func tagQuery(m list.Model) string {
+	if m.FilterState() != list.FilterApplied {
+		return ""
+	}
+
+	var terms []string
+	for _, field := range strings.Fields(strings.ToLower(m.FilterValue())) {
+		if strings.HasPrefix(field, tagFilter) {
+			terms = append(terms, field)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// startTaggedReview studies the due cards of the deck having the tags of the query,
+// so the daily limits apply and the cards are scheduled as in any other review.
+func startTaggedReview(query string, deck flashcard.Deck) tea.Cmd {
+	return func() tea.Msg {
+		tagged, err := flashcard.ParseQuery(query)
+		if err != nil {
+			return fail(err)
+		}
+		return setReviewPageMsg{
+			decks: []flashcard.Deck{deck},
+			opts:  []flashcard.ReviewOption{flashcard.WithCards(deck.Search(tagged))},
+		}
+	}
+}
+
+func toggleHelp(active bool, action string) string {
+	if active {
+		return "un" + action
+	}
+	return action
+}
+
 // Form Card
 
 type cardFormKeyMap struct {
-	submit   key.Binding
-	cancel   key.Binding
-	previous key.Binding
-	next     key.Binding
+	submit         key.Binding
+	cancel         key.Binding
+	previous       key.Binding
+	next           key.Binding
+	reverse        key.Binding
+	typeAnswer     key.Binding
+	multipleChoice key.Binding
+	noteType       key.Binding
+	flag           key.Binding
 }
 
 func (k cardFormKeyMap) ShortHelp() []key.Binding {
@@ -342,7 +808,13 @@ func (f field) View() string {
 	return fieldStyle.Render(f.Model.View())
 }
 
-func newCardForm(question, answer string, shared Shared) cardForm {
+func newCardForm(
+	types []flashcard.NoteType,
+	noteType flashcard.NoteType,
+	values map[string]string,
+	options cardOptions,
+	shared Shared,
+) cardForm {
 	keyMap := cardFormKeyMap{
 		submit: key.NewBinding(
 			key.WithKeys("ctrl+s"),
@@ -360,35 +832,119 @@ func newCardForm(question, answer string, shared Shared) cardForm {
 			key.WithKeys("tab"),
 			key.WithHelp("tab", "down"),
 		),
+		reverse: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "reverse"),
+		),
+		typeAnswer: key.NewBinding(
+			key.WithKeys("ctrl+y"),
+			key.WithHelp("ctrl+y", "type answer"),
+		),
+		multipleChoice: key.NewBinding(
+			key.WithKeys("ctrl+o"),
+			key.WithHelp("ctrl+o", "choices"),
+		),
+		noteType: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "note type"),
+		),
+		flag: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "flag"),
+		),
 	}
+	// the note type can be changed only while the note is being created.
+	keyMap.noteType.SetEnabled(len(types) > 1)
 
-	questionInput := textarea.New()
-	questionInput.SetWidth(shared.width)
-	questionInput.SetValue(breakLines(question))
-	questionInput.Placeholder = "Enter a question"
-	questionInput.ShowLineNumbers = false
-	questionInput.CursorEnd()
-	questionInput.Focus()
+	model := cardForm{
+		Shared:  shared,
+		keyMap:  keyMap,
+		types:   types,
+		options: options,
+	}
 
-	answerInput := textarea.New()
-	answerInput.SetWidth(shared.width)
-	answerInput.SetValue(breakLines(answer))
-	answerInput.Placeholder = "Enter an answer"
-	answerInput.ShowLineNumbers = false
-	answerInput.CursorEnd()
-	answerInput.Blur()
+	return model.withNoteType(noteType, values)
+}
+
+// withNoteType replaces the inputs by the ones of the note type fields, filled with the values.
+func (m cardForm) withNoteType(noteType flashcard.NoteType, values map[string]string) cardForm {
+	m.noteType = noteType
+	m.fields = make([]field, 0, len(noteType.Fields)+4)
+	for i, name := range noteType.Fields {
+		input := textarea.New()
+		input.SetWidth(m.width)
+		input.SetValue(breakLines(values[name]))
+		input.Placeholder = placeholder(name)
+		input.ShowLineNumbers = false
+		input.CursorEnd()
+		if i == 0 {
+			input.Focus()
+		} else {
+			input.Blur()
+		}
+		m.fields = append(m.fields, field{Model: input, name: name})
+	}
 
-	model := cardForm{
-		Shared: shared,
-		cursor: newCursor(1),
-		fields: []field{
-			{Model: questionInput, name: "question"},
-			{Model: answerInput, name: "answer"},
-		},
-		keyMap: keyMap,
+	if m.isBasic() && m.options.multipleChoice {
+		input := textarea.New()
+		input.SetWidth(m.width)
+		input.SetValue(values[distractorsField])
+		input.Placeholder = "Enter the wrong choices, one per line, or leave it empty to draw them from the deck"
+		input.ShowLineNumbers = false
+		input.CursorEnd()
+		input.Blur()
+		m.fields = append(m.fields, field{Model: input, name: distractorsField})
 	}
 
-	return model
+	input := textarea.New()
+	input.SetWidth(m.width)
+	input.SetValue(values[tagsField])
+	input.Placeholder = "Enter the tags separated by spaces"
+	input.ShowLineNumbers = false
+	input.CursorEnd()
+	input.Blur()
+	m.fields = append(m.fields, field{Model: input, name: tagsField})
+
+	input = textarea.New()
+	input.SetWidth(m.width)
+	input.SetValue(values[hintsField])
+	input.Placeholder = "Enter the hints, one per line, revealed in order"
+	input.ShowLineNumbers = false
+	input.CursorEnd()
+	input.Blur()
+	m.fields = append(m.fields, field{Model: input, name: hintsField})
+
+	input = textarea.New()
+	input.SetWidth(m.width)
+	input.SetValue(values[notesField])
+	input.Placeholder = "Enter your notes about the card"
+	input.ShowLineNumbers = false
+	input.CursorEnd()
+	input.Blur()
+	m.fields = append(m.fields, field{Model: input, name: notesField})
+	m.cursor = newCursor(len(m.fields) - 1)
+
+	// the cloze deletions, reverse and multiple choice cards are made from the Basic question and answer.
+	m.keyMap.reverse.SetEnabled(m.isBasic())
+	m.keyMap.multipleChoice.SetEnabled(m.isBasic())
+
+	return m
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
+// placeholder asks for the value of the field, as in "Enter a question" or "Enter an answer".
+func placeholder(name string) string {
+	name = strings.ToLower(name)
+	if strings.IndexAny(name, "aeiou") == 0 {
+		return "Enter an " + name
+	}
+	return "Enter a " + name
 }
 
 type cardForm struct {
@@ -396,6 +952,57 @@ type cardForm struct {
 	cursor cursor
 	fields []field
 	keyMap cardFormKeyMap
+	// types are the note types the form can switch between, empty when the type cannot change.
+	types    []flashcard.NoteType
+	noteType flashcard.NoteType
+	options  cardOptions
+}
+
+func (m cardForm) isBasic() bool {
+	return m.noteType.Name == flashcard.BasicNoteType
+}
+
+// values returns the values of the note fields, the tags, hints and notes left out.
+func (m cardForm) values() map[string]string {
+	values := m.inputs()
+	delete(values, tagsField)
+	delete(values, hintsField)
+	delete(values, notesField)
+	return values
+}
+
+// inputs returns the values of all the fields of the form.
+func (m cardForm) inputs() map[string]string {
+	values := make(map[string]string, len(m.fields))
+	for _, field := range m.fields {
+		values[field.name] = field.Value()
+	}
+	return values
+}
+
+// cardOptions returns the options of the form along with the tags, hints and notes typed.
+func (m cardForm) cardOptions() cardOptions {
+	options := m.options
+	options.tags = flashcard.ParseTags(m.Value(tagsField))
+	options.hints = flashcard.ParseHints(m.Value(hintsField))
+	options.notes = m.Value(notesField)
+	return options
+}
+
+// toggleMultipleChoice shows or hides the distractors field, keeping the focus on the same field when possible.
+func (m cardForm) toggleMultipleChoice() (cardForm, tea.Cmd) {
+	index := m.cursor.Value()
+	m.options.multipleChoice = !m.options.multipleChoice
+	m = m.withNoteType(m.noteType, m.inputs())
+	m.cursor.index = min(index, m.cursor.max)
+	return m.focus(m.cursor.Value())
+}
+
+// switchNoteType moves to the next note type, keeping the values of the fields with the same name.
+func (m cardForm) switchNoteType() (cardForm, tea.Cmd) {
+	index := slices.IndexFunc(m.types, func(t flashcard.NoteType) bool { return t.Name == m.noteType.Name })
+	m = m.withNoteType(m.types[(index+1)%len(m.types)], m.inputs())
+	return m, m.Init()
 }
 
 func (m cardForm) Init() tea.Cmd {
@@ -417,9 +1024,18 @@ func (m cardForm) focus(index int) (cardForm, tea.Cmd) {
 	return m, cmd
 }
 
+// isValid requires every field of the Basic notes, but the tags, the hints, the notes, the distractors and the answer of a
+// cloze text which are optional, and the first field of the other note types.
 func (m cardForm) isValid() bool {
+	if !m.isBasic() {
+		return m.fields[0].IsValid()
+	}
+
+	cloze := flashcard.HasCloze(m.Value("question"))
 	for _, field := range m.fields {
-		if !field.IsValid() {
+		optional := slices.Contains([]string{tagsField, hintsField, notesField, distractorsField}, field.name) ||
+			cloze && strings.EqualFold(field.name, "answer")
+		if !field.IsValid() && !optional {
 			return false
 		}
 	}
@@ -465,6 +1081,24 @@ func (m cardForm) Update(msg tea.Msg) (cardForm, tea.Cmd) {
 		case key.Matches(msg, m.keyMap.cancel):
 			return m, cancelForm()
 
+		case key.Matches(msg, m.keyMap.reverse):
+			m.options.reversible = !m.options.reversible
+			return m, nil
+
+		case key.Matches(msg, m.keyMap.typeAnswer):
+			m.options.typeAnswer = !m.options.typeAnswer
+			return m, nil
+
+		case key.Matches(msg, m.keyMap.multipleChoice):
+			return m.toggleMultipleChoice()
+
+		case key.Matches(msg, m.keyMap.noteType):
+			return m.switchNoteType()
+
+		case key.Matches(msg, m.keyMap.flag):
+			m.options.flag = m.options.flag.Next()
+			return m, nil
+
 		case key.Matches(msg, m.keyMap.submit):
 			if m.isValid() {
 				return m, submitForm(m)
@@ -505,7 +1139,7 @@ func (m cardForm) View() string {
 }
 
 func (m cardForm) view(height int) string {
-	content := make([]string, len(m.fields))
+	content := make([]string, len(m.fields), len(m.fields)+1)
 	// each input will have 30% of the available height
 	inputHeight := max(5, height/(len(m.fields)+1))
 
@@ -514,13 +1148,37 @@ func (m cardForm) view(height int) string {
 		content[i] = field.View()
 	}
 
+	// the options are toggled by their keys, shown next to them instead of the help.
+	var options []string
+	if len(m.types) > 1 {
+		options = append(options, optionView("Note type", m.noteType.Name, m.keyMap.noteType))
+	}
+	if m.isBasic() {
+		options = append(
+			options,
+			optionView("Reverse card", yesNo(m.options.reversible), m.keyMap.reverse),
+			optionView("Multiple choice", yesNo(m.options.multipleChoice), m.keyMap.multipleChoice),
+		)
+	}
+	options = append(
+		options,
+		optionView("Type the answer", yesNo(m.options.typeAnswer), m.keyMap.typeAnswer),
+		optionView("Flag", m.options.flag.String(), m.keyMap.flag),
+	)
+	content = append(content, fieldStyle.Render(lipgloss.JoinVertical(lipgloss.Top, options...)))
+
 	return lipgloss.JoinVertical(lipgloss.Top, content...)
 }
 
+func optionView(label, value string, binding key.Binding) string {
+	return fmt.Sprintf("%s: %s • %s", label, value, binding.Help().Key)
+}
+
 // Add Card
 
 func newCardAddPage(shared cardShared) cardAddPage {
-	return cardAddPage{form: newCardForm("", "", shared.Shared), cardShared: shared}
+	types := shared.deck.Types()
+	return cardAddPage{form: newCardForm(types, types[0], nil, cardOptions{}, shared.Shared), cardShared: shared}
 }
 
 type cardAddPage struct {
@@ -543,7 +1201,7 @@ func (m cardAddPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case submittedFormMsg[cardForm]:
 		return m, tea.Batch(
 			showLoading(m.deck.Name, "Creating card..."),
-			createCard(msg.data.Value("question"), m.form.Value("answer"), m.cardShared),
+			createCard(msg.data.noteType.Name, msg.data.values(), msg.data.cardOptions(), m.cardShared),
 		)
 
 	case canceledFormMsg:
@@ -574,7 +1232,27 @@ func (m cardAddPage) View() string {
 // Edit Card
 
 func newCardEditPage(card flashcard.Card, shared cardShared) cardEditPage {
-	return cardEditPage{card: card, form: newCardForm(card.Question, card.Answer, shared.Shared), cardShared: shared}
+	noteType, err := shared.deck.NoteType(card.NoteType())
+	if err != nil {
+		// the note type was removed from the deck, its fields are still in the card.
+		noteType = flashcard.NoteType{Name: card.Type, Fields: slices.Sorted(maps.Keys(card.Fields))}
+	}
+	values := maps.Clone(card.Values())
+	if noteType.Name == flashcard.BasicNoteType {
+		values[distractorsField] = strings.Join(card.Distractors, "\n")
+	}
+	values[tagsField] = strings.Join(card.Tags, " ")
+	values[hintsField] = strings.Join(card.Hints, "\n")
+	values[notesField] = card.Notes
+	_, reversible := shared.deck.Reverse(card)
+	options := cardOptions{
+		reversible:     reversible,
+		typeAnswer:     card.TypeAnswer,
+		multipleChoice: card.MultipleChoice,
+		flag:           card.Flag,
+	}
+	form := newCardForm(nil, noteType, values, options, shared.Shared)
+	return cardEditPage{card: card, form: form, cardShared: shared}
 }
 
 type cardEditPage struct {
@@ -598,12 +1276,18 @@ func (m cardEditPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width, m.height = msg.Width, msg.Height
 
 	case submittedFormMsg[cardForm]:
-		m.card.Answer = msg.data.Value("answer")
-		m.card.Question = msg.data.Value("question")
+		if !msg.data.isBasic() {
+			return m, tea.Batch(
+				showLoading(m.deck.Name, "Updating card..."),
+				updateNote(m.card, msg.data.values(), msg.data.cardOptions(), m.cardShared),
+			)
+		}
+
+		m.card.Distractors = strings.Split(msg.data.Value(distractorsField), "\n")
 
 		return m, tea.Batch(
 			showLoading(m.deck.Name, "Updating card..."),
-			updateCard(m.card, m.cardShared),
+			updateCard(m.card, msg.data.Value("question"), msg.data.Value("answer"), msg.data.cardOptions(), m.cardShared),
 		)
 
 	case canceledFormMsg:
@@ -730,18 +1414,82 @@ func (m cardDeletePage) View() string {
 	return m.styles.List.Render(m.list.View())
 }
 
+// Tag Cards
+
+func newCardTagPage(shared cardShared) cardTagPage {
+	cards := selectedCards(shared.list)
+
+	form := newDeckForm("", shared.Shared)
+	form.input.CharLimit = 0
+	form.input.Placeholder = "Enter the tags to add, or to remove prefixed with -"
+	return cardTagPage{cardShared: shared, cards: cards, form: form}
+}
+
+// cardTagPage tags at once the cards selected in the list or, when none is, the cards shown,
+// all of them or the ones matching the filter.
+type cardTagPage struct {
+	cardShared
+	cards []flashcard.Card
+	form  deckForm
+}
+
+func (m cardTagPage) Init() tea.Cmd {
+	m.Log("card-tag: init")
+
+	return m.form.Init()
+}
+
+func (m cardTagPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("cardTag update: %T", msg)
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case submittedFormMsg[textinput.Model]:
+		return m, tea.Batch(
+			showLoading(m.deck.Name, "Tagging cards..."),
+			tagCards(m.cards, flashcard.ParseTags(msg.data.Value()), m.cardShared),
+		)
+
+	case canceledFormMsg:
+		return m, showBrowseCard(m.list)
+	}
+
+	m.form, cmd = m.form.Update(msg)
+	return m, cmd
+}
+
+func (m cardTagPage) View() string {
+	m.Log("cardTag view: width=%d height=%d", m.width, m.height)
+
+	header := m.styles.Title.
+		Margin(1, 0, 0, 2).
+		Render(m.deck.Name)
+
+	subTitle := m.styles.DimmedTitle.
+		Margin(1, 0, 1, 2).
+		Render(fmt.Sprintf("Tag %d card%s", len(m.cards), pluralize(len(m.cards), "s")))
+
+	m.form.width, m.form.height = m.width, m.height-lipgloss.Height(header)-lipgloss.Height(subTitle)
+	form := m.styles.Text.Render(m.form.View())
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, form)
+}
+
 // Card Page
 
-func newCardPage(parent Shared, deck flashcard.Deck) cardPage {
+func newCardPage(parent Shared, deck flashcard.Deck, index int) cardPage {
 	delegate := list.NewDefaultDelegate()
 	shared := cardShared{
 		Shared:   parent,
 		delegate: &delegate,
-		list:     list.New(newCardItems(deck.List(), parent.clock), &delegate, parent.width, parent.height),
+		list:     list.New(newCardItems(deck, parent.clock), &delegate, parent.width, parent.height),
 		deck:     deck,
 	}
 	shared.list.SetSize(shared.width, shared.height)
+	shared.list.Select(index)
 	shared.list.Title = deck.Name
+	shared.list.Filter = filterCards
 	shared.list.Styles.NoItems = shared.list.Styles.NoItems.Copy().Margin(0, 2)
 
 	return cardPage{
@@ -801,28 +1549,46 @@ func (m cardPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.page = newDeleteCardPage(m.cardShared)
 		return m, cmd
 
-	case cardCreatedMsg:
+	case showTagCardsMsg:
 		m.list = msg.list
+		m.page = newCardTagPage(m.cardShared)
+		return m, m.page.Init()
+
+	case cardsSavedMsg:
 		m.deck = msg.deck
-		m.list.InsertItem(m.list.Index(), cardItem{Card: msg.card, clock: m.clock})
+		m.list = msg.list
 		m.list.ResetFilter()
+		m.list.SetItems(newCardItems(m.deck, m.clock))
+		m.list.Select(slices.IndexFunc(m.deck.List(), func(card flashcard.Card) bool { return card.ID == msg.card.ID }))
 		m.page = newCardBrowsePage(m.cardShared)
 		return m, nil
 
-	case cardChangedMsg:
+	case cardsTaggedMsg:
+		m.deck = msg.deck
+		m.list = msg.list
+		// the filter stays applied, matching the tags just changed.
+		cmd = m.list.SetItems(newCardItems(m.deck, m.clock))
+		m.page = newCardBrowsePage(m.cardShared)
+		return m, cmd
+
+	case cardRescheduledMsg:
 		m.list = msg.list
 		m.deck = msg.deck
-		m.list.RemoveItem(m.list.Index())
-		m.list.InsertItem(m.list.Index()-1, cardItem{Card: msg.card, clock: m.clock})
-		m.list.ResetFilter()
+		cmd = m.list.SetItem(m.list.GlobalIndex(), cardItem{Card: msg.card, clock: m.clock, settings: m.deck.Settings, selected: isCardSelected(m.list)})
 		m.page = newCardBrowsePage(m.cardShared)
-		return m, nil
+		return m, cmd
 
 	case cardDeletedMsg:
 		m.list = msg.list
 		m.deck = msg.deck
 		m.list.RemoveItem(m.list.Index())
 		m.list.ResetFilter()
+		// the reverse of the card was deleted along with it.
+		if len(m.list.Items()) != m.deck.Total() {
+			index := m.list.Index()
+			m.list.SetItems(newCardItems(m.deck, m.clock))
+			m.list.Select(max(0, min(index, len(m.list.Items())-1)))
+		}
 		m.page = newCardBrowsePage(m.cardShared)
 		return m, nil
 	}
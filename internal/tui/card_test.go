@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/dustin/go-humanize"
@@ -74,12 +75,15 @@ func TestCardsList(t *testing.T) {
 				Get().
 				View()
 
-			assert.Contains(t, view, "↑/k      up             /     filter    q quit")
-			assert.Contains(t, view, "↓/j      down           a     add       ? close help")
+			assert.Contains(t, view, "↑/k      up             /     filter       q quit")
+			assert.Contains(t, view, "↓/j      down           a     add          ? close help")
 			assert.Contains(t, view, "→/l/pgdn next page      e     edit")
 			assert.Contains(t, view, " ←/h/pgup prev page      x     delete")
 			assert.Contains(t, view, "g/home   go to start    enter stats")
 			assert.Contains(t, view, "G/end    go to end      s     study")
+			assert.Contains(t, view, "u     suspend")
+			assert.Contains(t, view, "b     bury")
+			assert.Contains(t, view, "U     suspended")
 		},
 	)
 
@@ -161,7 +165,7 @@ func TestCardsList(t *testing.T) {
 
 	t.Run(
 		"does not start review when it does not have due cards", func(t *testing.T) {
-			view := newTestModel(t, singleCardDeck, tui.WithClock(clock.Clock{Time: latestCard.LastReview})).
+			view := newTestModel(t, singleCardDeck, tui.WithClock(clock.Clock{Time: latestCard.LastReview.Add(-24 * time.Hour)})).
 				Init().
 				SendKeyType(tea.KeyEnter).
 				SendKeyRune(studyKey).
@@ -169,6 +173,45 @@ func TestCardsList(t *testing.T) {
 				View()
 
 			assert.Contains(t, view, "Golang One")
+			assert.NotContains(t, view, "1 of 1")
+		},
+	)
+
+	t.Run(
+		"marks the cards due in the current study day", func(t *testing.T) {
+			tests := []struct {
+				name string
+				time time.Time
+				want bool
+			}{
+				{
+					name: "before the card due time",
+					time: latestCard.LastReview.Add(-time.Hour),
+					want: true,
+				},
+				{
+					name: "before the day boundary",
+					time: time.Date(2021, 1, 8, 3, 0, 0, 0, time.UTC),
+					want: false,
+				},
+			}
+			for _, tt := range tests {
+				t.Run(
+					tt.name, func(t *testing.T) {
+						view := newTestModel(t, singleCardDeck, tui.WithClock(clock.New(tt.time))).
+							Init().
+							SendKeyType(tea.KeyEnter).
+							Get().
+							View()
+
+						if tt.want {
+							assert.Contains(t, view, "• due")
+						} else {
+							assert.NotContains(t, view, "• due")
+						}
+					},
+				)
+			}
 		},
 	)
 
@@ -225,6 +268,106 @@ func TestCardsList(t *testing.T) {
 	)
 }
 
+func TestCardSuspend(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"suspends the card", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(suspendKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, activePrompt+latestCard.Question)
+			assert.Contains(t, view, fmt.Sprintf("%sLast review %s • suspended", activePrompt, humanize.Time(latestCard.LastReview)))
+		},
+	)
+
+	t.Run(
+		"unsuspends the card", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(suspendKey).
+				SendKeyRune(suspendKey).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "• suspended")
+		},
+	)
+
+	t.Run(
+		"lists the suspended cards", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(keyDown).
+				SendKeyRune(suspendKey).
+				SendKeyRune("U").
+				Get().
+				View()
+
+			assert.Contains(t, view, "1 item • 5 filtered")
+			assert.Contains(t, view, activePrompt+secondLatestCard.Question)
+		},
+	)
+
+	t.Run(
+		"combines the properties with the question filter", func(t *testing.T) {
+			newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(suspendKey).
+				SendKeyRune(filterKey).
+				SendKeyRune("is:due B").
+				SendKeyType(tea.KeyEnter).
+				Peek(
+					func(m tea.Model) {
+						view := m.View()
+						assert.Contains(t, view, "1 item • 5 filtered")
+						assert.Contains(t, view, secondLatestCard.Question)
+					},
+				)
+		},
+	)
+}
+
+func TestCardBury(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"buries the card", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(buryKey).
+				SendKeyRune(helpKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, fmt.Sprintf("%sLast review %s • buried", activePrompt, humanize.Time(latestCard.LastReview)))
+			assert.Contains(t, view, "b     unbury")
+		},
+	)
+
+	t.Run(
+		"unburies the card", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(buryKey).
+				SendKeyRune(buryKey).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "• buried")
+		},
+	)
+}
+
 func TestCardAdd(t *testing.T) {
 	t.Parallel()
 
@@ -311,6 +454,50 @@ func TestCardAdd(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"creates a card per cloze without answer", func(t *testing.T) {
+			view := newTestModel(t, emptyDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				SendKeyRune("{{c1::Go}} is {{c2::fast}}").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "2 items")
+			assert.Contains(t, view, activePrompt+"**[...]** is fast")
+			assert.Contains(t, view, "Go is **[...]**")
+			assert.Contains(t, view, "cloze 2")
+		},
+	)
+
+	t.Run(
+		"creates a card with its reverse", func(t *testing.T) {
+			view := newTestModel(t, emptyDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				SendKeyRune("dog").
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("cachorro").
+				SendKeyRune(reverseKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Reverse card: yes • ctrl+r")
+					},
+				).
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "2 items")
+			assert.Contains(t, view, "dog")
+			assert.Contains(t, view, "cachorro")
+			assert.Contains(t, view, "reversed")
+		},
+	)
+
 	t.Run(
 		"shows error when card the creation fail", func(t *testing.T) {
 			view := newTestModel(t, errorDeck).
@@ -463,6 +650,201 @@ func TestCardEdit(t *testing.T) {
 	)
 }
 
+func TestCardEditCloze(t *testing.T) {
+	t.Parallel()
+
+	view := newTestModel(t, emptyDeck).
+		Init().
+		SendKeyType(tea.KeyEnter).
+		SendKeyRune(createKey).
+		SendKeyRune("{{c1::Go}} is fast").
+		SendKeyRune(saveKey).
+		SendKeyRune(editKey).
+		SendKeyType(tea.KeyEnd).
+		SendKeyRune(" and {{c2::simple}}").
+		SendKeyRune(saveKey).
+		Get().
+		View()
+
+	assert.Contains(t, view, "2 items")
+	assert.Contains(t, view, "**[...]** is fast and simple")
+	assert.Contains(t, view, "Go is fast and **[...]**")
+}
+
+func TestCardEditReversible(t *testing.T) {
+	t.Parallel()
+
+	newReversibleModel := func(t *testing.T) *testModel {
+		return newTestModel(t, emptyDeck).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(createKey).
+			SendKeyRune("dog").
+			SendKeyType(tea.KeyTab).
+			SendKeyRune("cachorro").
+			SendKeyRune(reverseKey).
+			SendKeyRune(saveKey)
+	}
+
+	t.Run(
+		"updates the reverse of the card", func(t *testing.T) {
+			view := newReversibleModel(t).
+				SendKeyRune(editKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Reverse card: yes • ctrl+r")
+					},
+				).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyEnd).
+				SendKeyRune("s").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "2 items")
+			assert.Contains(t, view, "cachorros")
+		},
+	)
+
+	t.Run(
+		"removes the reverse of the card", func(t *testing.T) {
+			view := newReversibleModel(t).
+				SendKeyRune(editKey).
+				SendKeyRune(reverseKey).
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "1 item")
+			assert.NotContains(t, view, "reversed")
+		},
+	)
+
+	t.Run(
+		"deletes the card with its reverse", func(t *testing.T) {
+			view := newReversibleModel(t).
+				SendKeyRune(deleteKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "No items.")
+		},
+	)
+}
+
+func TestCardNote(t *testing.T) {
+	t.Parallel()
+
+	newNoteModel := func(t *testing.T) *testModel {
+		return newTestModel(t, notesDeck).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(createKey).
+			SendKeyRune(noteTypeKey).
+			SendKeyRune("dog").
+			SendKeyType(tea.KeyTab).
+			SendKeyRune("cachorro").
+			SendKeyRune(saveKey)
+	}
+
+	t.Run(
+		"builds the form from the note type fields", func(t *testing.T) {
+			view := newTestModel(t, notesDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Note type: Basic • ctrl+t")
+						assert.Contains(t, m.View(), "Enter a question")
+					},
+				).
+				SendKeyRune(noteTypeKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Note type: Word • ctrl+t")
+			assert.Contains(t, view, "Enter a word")
+			assert.Contains(t, view, "Enter a meaning")
+			assert.Contains(t, view, "Enter an example")
+			assert.NotContains(t, view, "Reverse card")
+		},
+	)
+
+	t.Run(
+		"creates a card per template", func(t *testing.T) {
+			view := newNoteModel(t).Get().View()
+
+			assert.Contains(t, view, "2 items")
+			assert.Contains(t, view, activePrompt+"dog")
+			assert.Contains(t, view, "cachorro")
+			assert.Contains(t, view, "Word")
+		},
+	)
+
+	t.Run(
+		"updates the cards of the note", func(t *testing.T) {
+			view := newNoteModel(t).
+				SendKeyRune(editKey).
+				Peek(
+					func(m tea.Model) {
+						assert.NotContains(t, m.View(), "Note type:")
+						assert.Contains(t, m.View(), "Enter an example")
+					},
+				).
+				SendKeyType(tea.KeyEnd).
+				SendKeyRune("s").
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyEnd).
+				SendKeyRune("s").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "2 items")
+			assert.Contains(t, view, "dogs")
+			assert.Contains(t, view, "cachorros")
+		},
+	)
+}
+
+func TestCardEditChoices(t *testing.T) {
+	t.Parallel()
+
+	view := newTestModel(t, emptyDeck).
+		Init().
+		SendKeyType(tea.KeyEnter).
+		SendKeyRune(createKey).
+		SendKeyRune(choicesKey).
+		Peek(
+			func(m tea.Model) {
+				assert.Contains(t, m.View(), "Multiple choice: yes • ctrl+o")
+				assert.Contains(t, m.View(), "Enter the wrong choices")
+			},
+		).
+		SendKeyRune("Capital of France").
+		SendKeyType(tea.KeyTab).
+		SendKeyRune("Paris").
+		SendKeyType(tea.KeyTab).
+		SendKeyRune("Lyon").
+		SendKeyRune(saveKey).
+		SendKeyRune(editKey).
+		Peek(
+			func(m tea.Model) {
+				assert.Contains(t, m.View(), "Multiple choice: yes • ctrl+o")
+				assert.Contains(t, m.View(), "Lyon")
+			},
+		).
+		SendKeyRune(choicesKey).
+		Get().
+		View()
+
+	assert.Contains(t, view, "Multiple choice: no • ctrl+o")
+	assert.NotContains(t, view, "Lyon")
+}
+
 func TestCardDelete(t *testing.T) {
 	t.Parallel()
 
@@ -632,3 +1014,307 @@ func TestCardDelete(t *testing.T) {
 		},
 	)
 }
+
+func TestCardReset(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"resets the card to new", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune("r").
+				Get().
+				View()
+
+			assert.Contains(t, view, activePrompt+"Question A")
+			assert.Contains(t, view, "• due")
+			assert.NotContains(t, view, "• leech")
+		},
+	)
+
+	t.Run(
+		"is disabled for new cards", func(t *testing.T) {
+			view := newTestModel(t, singleCardDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(helpKey).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "reset")
+		},
+	)
+}
+
+func TestCardTags(t *testing.T) {
+	t.Parallel()
+
+	filterTag := func(t *testing.T, tag string) *testModel {
+		t.Helper()
+
+		return newTestModel(t, tagsDeck).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(filterKey).
+			SendKeyRune(tag).
+			SendKeyType(tea.KeyEnter)
+	}
+
+	t.Run(
+		"filters the cards by tag", func(t *testing.T) {
+			view := filterTag(t, "tag:linux").
+				Get().
+				View()
+
+			assert.Contains(t, view, "What does ls do?")
+			assert.Contains(t, view, "#networking • #linux")
+			assert.NotContains(t, view, "What does TCP stand for?")
+		},
+	)
+
+	t.Run(
+		"tags the cards shown", func(t *testing.T) {
+			view := filterTag(t, "tag:networking").
+				SendKeyRune(tagKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Tag 2 cards")
+					},
+				).
+				SendKeyRune("review -protocols").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "#networking • #review")
+			assert.Contains(t, view, "#networking • #linux • #review")
+			assert.NotContains(t, view, "#protocols")
+			assert.NotContains(t, view, "What does ls do?")
+		},
+	)
+
+	t.Run(
+		"tags the cards selected", func(t *testing.T) {
+			view := newTestModel(t, tagsDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyType(tea.KeySpace).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "✓ ")
+					},
+				).
+				SendKeyRune(tagKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Tag 1 card")
+					},
+				).
+				SendKeyRune("review").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Equal(t, 1, strings.Count(view, "#review"))
+			assert.NotContains(t, view, "✓ ")
+		},
+	)
+
+	t.Run(
+		"studies the cards of the tag", func(t *testing.T) {
+			view := filterTag(t, "tag:linux").
+				SendKeyRune(studyKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang Tags")
+			assert.Contains(t, view, "1 of 2")
+		},
+	)
+
+	t.Run(
+		"edits the tags of the card", func(t *testing.T) {
+			view := newTestModel(t, emptyDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				SendKeyRune("Question").
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("Answer").
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("go, basics").
+				SendKeyRune(saveKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "#go • #basics")
+					},
+				).
+				SendKeyRune(editKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "go basics")
+					},
+				).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyType(tea.KeyBackspace).
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "#go")
+			assert.NotContains(t, view, "#basics")
+		},
+	)
+}
+
+func TestCardHints(t *testing.T) {
+	t.Parallel()
+
+	view := newTestModel(t, emptyDeck).
+		Init().
+		SendKeyType(tea.KeyEnter).
+		SendKeyRune(createKey).
+		Peek(
+			func(m tea.Model) {
+				assert.Contains(t, m.View(), "Enter the hints, one per line, revealed in order")
+			},
+		).
+		SendKeyRune("What does TCP stand for?").
+		SendKeyType(tea.KeyTab).
+		SendKeyRune("Transmission Control Protocol").
+		SendKeyType(tea.KeyTab).
+		SendKeyType(tea.KeyTab).
+		SendKeyRune("Transport layer").
+		SendKeyRune(saveKey).
+		SendKeyRune(editKey).
+		Get().
+		View()
+
+	assert.Contains(t, view, "Transport layer")
+}
+
+func TestCardFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"shows the flag of the cards", func(t *testing.T) {
+			view := newTestModel(t, flagsDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ red")
+					},
+				).
+				SendKeyRune(helpKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "flagged")
+		},
+	)
+
+	t.Run(
+		"filters the flagged cards", func(t *testing.T) {
+			view := newTestModel(t, flagsDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flagKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "What does TCP stand for?")
+			assert.NotContains(t, view, "What does ls do?")
+		},
+	)
+
+	t.Run(
+		"filters the cards by flag colour", func(t *testing.T) {
+			view := newTestModel(t, flagsDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(filterKey).
+				SendKeyRune("flag:red").
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "What does TCP stand for?")
+			assert.NotContains(t, view, "What does ls do?")
+		},
+	)
+
+	t.Run(
+		"sets the flag and notes in the form", func(t *testing.T) {
+			view := newTestModel(t, emptyDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				SendKeyRune("Question").
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("Answer").
+				SendKeyRune(flagFormKey).
+				SendKeyRune(flagFormKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Flag: orange")
+					},
+				).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("Rewrite it").
+				SendKeyRune(saveKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ orange")
+					},
+				).
+				SendKeyRune(editKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Rewrite it")
+			assert.Contains(t, view, "Flag: orange")
+		},
+	)
+
+	t.Run(
+		"sets the flag and notes of the edited cloze only", func(t *testing.T) {
+			view := newTestModel(t, emptyDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(createKey).
+				SendKeyRune("{{c1::Go}} is {{c2::fast}}").
+				SendKeyRune(saveKey).
+				SendKeyType(tea.KeyUp).
+				SendKeyRune(editKey).
+				SendKeyRune(flagFormKey).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("Check the benchmark").
+				SendKeyRune(saveKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "cloze 2 • ⚑ red")
+						assert.NotContains(t, m.View(), "cloze 1 • ⚑ red")
+					},
+				).
+				SendKeyRune(editKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Check the benchmark")
+			assert.Contains(t, view, "Flag: red")
+		},
+	)
+}
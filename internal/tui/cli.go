@@ -2,15 +2,21 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/open-spaced-repetition/go-fsrs/v3"
 	"github.com/urfave/cli/v3"
 
+	"github.com/eliostvs/lembrol/internal/clock"
+	"github.com/eliostvs/lembrol/internal/flashcard"
 	"github.com/eliostvs/lembrol/internal/version"
 )
 
@@ -19,8 +25,20 @@ func CLI(args []string, stdout io.Writer, stderr io.Writer) int {
 		debugFlag   = "debug"
 		logFileFlag = "log-file"
 		decksPath   = "decks"
+		cardsFlag   = "cards"
+		minutesFlag = "minutes"
+		imagesFlag  = "images"
 	)
 
+	notNegative := func(name string) func(context.Context, *cli.Command, int) error {
+		return func(ctx context.Context, cmd *cli.Command, v int) error {
+			if v < 0 {
+				return fmt.Errorf("%s must not be negative", name)
+			}
+			return nil
+		}
+	}
+
 	cmd := &cli.Command{
 		Name:      strings.ToLower(appName),
 		Usage:     "Learning things through spaced repetition.",
@@ -42,6 +60,28 @@ func CLI(args []string, stdout io.Writer, stderr io.Writer) int {
 				Value: getDataHome(),
 				Usage: "path to directory contains decks",
 			},
+			&cli.IntFlag{
+				Name:   cardsFlag,
+				Usage:  "end the reviews after this number of cards",
+				Action: notNegative(cardsFlag),
+			},
+			&cli.IntFlag{
+				Name:   minutesFlag,
+				Usage:  "end the reviews after this number of minutes",
+				Action: notNegative(minutesFlag),
+			},
+			&cli.StringFlag{
+				Name:  imagesFlag,
+				Value: string(ImagesAuto),
+				Usage: "draw the images of the cards with auto, kitty, sixel or none",
+				Action: func(ctx context.Context, cmd *cli.Command, v string) error {
+					switch ImageProtocol(v) {
+					case ImagesAuto, ImagesKitty, ImagesSixel, ImagesNone:
+						return nil
+					}
+					return fmt.Errorf("unknown image protocol %q", v)
+				},
+			},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			if cmd.Bool(debugFlag) {
@@ -52,7 +92,9 @@ func CLI(args []string, stdout io.Writer, stderr io.Writer) int {
 				defer file.Close()
 			}
 
-			program := tea.NewProgram(NewModel(cmd.String(decksPath), cmd.Bool(debugFlag)), tea.WithAltScreen())
+			goal := flashcard.Goal{Cards: cmd.Int(cardsFlag), Minutes: cmd.Int(minutesFlag)}
+			model := NewModel(cmd.String(decksPath), cmd.Bool(debugFlag), WithGoal(goal), WithImages(ImageProtocol(cmd.String(imagesFlag))))
+			program := tea.NewProgram(model, tea.WithAltScreen())
 			_, err := program.Run()
 			return err
 		},
@@ -66,6 +108,12 @@ func CLI(args []string, stdout io.Writer, stderr io.Writer) int {
 					return nil
 				},
 			},
+			rescheduleCommand(decksPath, stdout),
+			simulateCommand(decksPath, stdout),
+			tagsCommand(decksPath, stdout),
+			exportCommand(decksPath, stdout),
+			importCommand(decksPath, stdout),
+			mediaCommand(decksPath, stdout),
 		},
 	}
 
@@ -77,6 +125,406 @@ func CLI(args []string, stdout io.Writer, stderr io.Writer) int {
 	return 0
 }
 
+func rescheduleCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const (
+		deckFlag      = "deck"
+		cardFlag      = "card"
+		daysFlag      = "days"
+		dateFlag      = "date"
+		keepStatsFlag = "keep-stats"
+	)
+
+	deck := &cli.StringFlag{
+		Name:     deckFlag,
+		Usage:    "name of the deck",
+		Required: true,
+	}
+	card := &cli.StringFlag{
+		Name:     cardFlag,
+		Usage:    "id of the card",
+		Required: true,
+	}
+	days := &cli.IntFlag{
+		Name:  daysFlag,
+		Value: rescheduleDays,
+		Usage: "number of days",
+		Action: func(ctx context.Context, cmd *cli.Command, v int) error {
+			if v <= 0 {
+				return errors.New("days must be greater than zero")
+			}
+			return nil
+		},
+	}
+
+	// withDeck loads the deck given in the flags, applies the change and saves the result.
+	withDeck := func(cmd *cli.Command, change func(flashcard.Deck) (flashcard.Deck, error)) error {
+		repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+		if err != nil {
+			return err
+		}
+
+		deck, err := repository.Find(cmd.String(deckFlag))
+		if err != nil {
+			return err
+		}
+
+		deck, err = change(deck)
+		if err != nil {
+			return err
+		}
+
+		return repository.Save(deck)
+	}
+
+	findCard := func(deck flashcard.Deck, id string) (flashcard.Card, error) {
+		for _, card := range deck.Cards {
+			if card.ID == id {
+				return card, nil
+			}
+		}
+		return flashcard.Card{}, fmt.Errorf("card %q not found", id)
+	}
+
+	return &cli.Command{
+		Name:  "reschedule",
+		Usage: "Change when the cards are due",
+		Commands: []*cli.Command{
+			{
+				Name:  "postpone",
+				Usage: "Postpone the cards due in the next days",
+				Flags: []cli.Flag{deck, days},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return withDeck(cmd, func(deck flashcard.Deck) (flashcard.Deck, error) {
+						deck, total := deck.Postpone(cmd.Int(daysFlag))
+						_, _ = fmt.Fprintf(stdout, "%d card%s postponed.\n", total, pluralize(total, "s"))
+						return deck, nil
+					})
+				},
+			},
+			{
+				Name:  "advance",
+				Usage: "Advance the cards due in the next days to today",
+				Flags: []cli.Flag{deck, days},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return withDeck(cmd, func(deck flashcard.Deck) (flashcard.Deck, error) {
+						deck, total := deck.Advance(cmd.Int(daysFlag))
+						_, _ = fmt.Fprintf(stdout, "%d card%s advanced.\n", total, pluralize(total, "s"))
+						return deck, nil
+					})
+				},
+			},
+			{
+				Name:  "forget",
+				Usage: "Reset a card to new",
+				Flags: []cli.Flag{
+					deck,
+					card,
+					&cli.BoolFlag{
+						Name:  keepStatsFlag,
+						Usage: "keep the review history",
+					},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return withDeck(cmd, func(deck flashcard.Deck) (flashcard.Deck, error) {
+						card, err := findCard(deck, cmd.String(cardFlag))
+						if err != nil {
+							return deck, err
+						}
+
+						deck, _ = deck.Forget(card, cmd.Bool(keepStatsFlag))
+						_, _ = fmt.Fprintln(stdout, "Card reset.")
+						return deck, nil
+					})
+				},
+			},
+			{
+				Name:  "due",
+				Usage: "Set the day a card is due",
+				Flags: []cli.Flag{
+					deck,
+					card,
+					&cli.StringFlag{
+						Name:     dateFlag,
+						Usage:    "due date in the YYYY-MM-DD format",
+						Required: true,
+					},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return withDeck(cmd, func(deck flashcard.Deck) (flashcard.Deck, error) {
+						date, err := time.ParseInLocation(time.DateOnly, cmd.String(dateFlag), deck.Settings.Location())
+						if err != nil {
+							return deck, fmt.Errorf("invalid date: %w", err)
+						}
+
+						card, err := findCard(deck, cmd.String(cardFlag))
+						if err != nil {
+							return deck, err
+						}
+
+						// The study day of the date begins some hours after midnight.
+						deck, _ = deck.SetDue(card, date.Add(time.Duration(deck.Settings.DayStartsAt)*time.Hour))
+						_, _ = fmt.Fprintf(stdout, "Card due on %s.\n", date.Format(time.DateOnly))
+						return deck, nil
+					})
+				},
+			},
+		},
+	}
+}
+
+func simulateCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const (
+		deckFlag      = "deck"
+		daysFlag      = "days"
+		retentionFlag = "retention"
+		newPerDayFlag = "new-per-day"
+		seedFlag      = "seed"
+	)
+
+	return &cli.Command{
+		Name:  "simulate",
+		Usage: "Forecast the reviews of a deck in the next days",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     deckFlag,
+				Usage:    "name of the deck",
+				Required: true,
+			},
+			&cli.IntFlag{
+				Name:  daysFlag,
+				Value: flashcard.DefaultSimulationDays,
+				Usage: "number of days",
+			},
+			&cli.FloatFlag{
+				Name:  retentionFlag,
+				Value: fsrs.DefaultParam().RequestRetention,
+				Usage: "desired retention, between 0 and 1",
+			},
+			&cli.IntFlag{
+				Name:  newPerDayFlag,
+				Usage: "new cards introduced per day, the deck limit by default",
+			},
+			&cli.IntFlag{
+				Name:  seedFlag,
+				Value: 1,
+				Usage: "seed of the answers drawn",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+			if err != nil {
+				return err
+			}
+
+			deck, err := repository.Find(cmd.String(deckFlag))
+			if err != nil {
+				return err
+			}
+
+			opts := deck.DefaultSimulationOptions()
+			opts.Days = cmd.Int(daysFlag)
+			opts.DesiredRetention = cmd.Float(retentionFlag)
+			opts.Seed = int64(cmd.Int(seedFlag))
+			if cmd.IsSet(newPerDayFlag) {
+				opts.NewPerDay = cmd.Int(newPerDayFlag)
+			}
+
+			simulation, err := deck.Simulate(opts)
+			if err != nil {
+				return err
+			}
+
+			writer := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(writer, "DATE\tREVIEWS\tNEW\tRETENTION")
+			for _, day := range simulation.Days {
+				_, _ = fmt.Fprintf(writer, "%s\t%d\t%d\t%s\n", day.Date.Format(time.DateOnly), day.Reviews, day.New, percent(day.Retention, day.Reviews))
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintln(stdout, simulationSummary(simulation))
+			return nil
+		},
+	}
+}
+
+func tagsCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const deckFlag = "deck"
+
+	return &cli.Command{
+		Name:  "tags",
+		Usage: "Show the stats of the cards of each tag of a deck",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     deckFlag,
+				Usage:    "name of the deck",
+				Required: true,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+			if err != nil {
+				return err
+			}
+
+			deck, err := repository.Find(cmd.String(deckFlag))
+			if err != nil {
+				return err
+			}
+
+			writer := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(writer, "TAG\tCARDS\tDUE\tREVIEWS\tRETENTION")
+			for _, tag := range deck.TagStats() {
+				_, _ = fmt.Fprintf(writer, "%s\t%d\t%d\t%d\t%s\n", tag.Tag, tag.Cards, tag.Due, tag.Reviews, percent(tag.Retention, tag.Reviews))
+			}
+			return writer.Flush()
+		},
+	}
+}
+
+func exportCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const (
+		deckFlag   = "deck"
+		outputFlag = "output"
+	)
+
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Export a deck and its media to a zip archive",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     deckFlag,
+				Usage:    "name of the deck",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     outputFlag,
+				Usage:    "path of the archive",
+				Required: true,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+			if err != nil {
+				return err
+			}
+
+			deck, err := repository.Find(cmd.String(deckFlag))
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(cmd.String(outputFlag))
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := repository.Export(deck, file); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(stdout, "Deck %s exported to %s.\n", deck.Name, cmd.String(outputFlag))
+			return file.Close()
+		},
+	}
+}
+
+func importCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const fileFlag = "file"
+
+	return &cli.Command{
+		Name:  "import",
+		Usage: "Import a deck and its media from a zip archive",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     fileFlag,
+				Usage:    "path of the archive",
+				Required: true,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(cmd.String(fileFlag))
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			info, err := file.Stat()
+			if err != nil {
+				return err
+			}
+
+			deck, err := repository.Import(file, info.Size())
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(stdout, "Deck %s imported with %d card%s.\n", deck.Name, deck.Total(), pluralize(deck.Total(), "s"))
+			return nil
+		},
+	}
+}
+
+func mediaCommand(decksPath string, stdout io.Writer) *cli.Command {
+	const (
+		deckFlag   = "deck"
+		deleteFlag = "delete"
+	)
+
+	return &cli.Command{
+		Name:  "media",
+		Usage: "List the media files of a deck not used by any card",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     deckFlag,
+				Usage:    "name of the deck",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  deleteFlag,
+				Usage: "delete the unused files",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			repository, err := flashcard.NewRepository(cmd.String(decksPath), clock.New())
+			if err != nil {
+				return err
+			}
+
+			deck, err := repository.Find(cmd.String(deckFlag))
+			if err != nil {
+				return err
+			}
+
+			orphans, err := repository.OrphanMedia(deck)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range orphans {
+				_, _ = fmt.Fprintln(stdout, name)
+			}
+
+			if !cmd.Bool(deleteFlag) {
+				return nil
+			}
+
+			if err := repository.RemoveMedia(deck, orphans); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(stdout, "%d file%s deleted.\n", len(orphans), pluralize(len(orphans), "s"))
+			return nil
+		},
+	}
+}
+
 func getDataHome() string {
 	homeDir, _ := os.UserHomeDir()
 	xdgDataHome := os.Getenv("XDG_DATA_HOME")
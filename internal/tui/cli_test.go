@@ -0,0 +1,216 @@
+package tui_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-spaced-repetition/go-fsrs/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eliostvs/lembrol/internal/clock"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+	"github.com/eliostvs/lembrol/internal/test"
+	"github.com/eliostvs/lembrol/internal/tui"
+)
+
+func TestCLI_Reschedule(t *testing.T) {
+	t.Parallel()
+
+	runIn := func(t *testing.T, location string, args ...string) (string, string, flashcard.Card) {
+		t.Helper()
+
+		var stdout, stderr bytes.Buffer
+		args = append([]string{"lembrol", "--decks", location, "reschedule"}, args...)
+
+		tui.CLI(args, &stdout, &stderr)
+
+		repo, err := flashcard.NewRepository(location, clock.New())
+		require.NoError(t, err)
+		deck, err := repo.Find("Golang Leech")
+		require.NoError(t, err)
+
+		for _, card := range deck.Cards {
+			if card.ID == "1" {
+				return stdout.String(), stderr.String(), card
+			}
+		}
+
+		t.Fatal("card not found")
+		return "", "", flashcard.Card{}
+	}
+
+	run := func(t *testing.T, args ...string) (string, string, flashcard.Card) {
+		t.Helper()
+
+		return runIn(t, test.TempCopyDir(t, leechDeck), args...)
+	}
+
+	t.Run(
+		"forgets the card", func(t *testing.T) {
+			stdout, _, card := run(t, "forget", "--deck", "Golang Leech", "--card", "1")
+
+			assert.Equal(t, "Card reset.\n", stdout)
+			assert.Equal(t, fsrs.New, card.State)
+			assert.Zero(t, card.Lapses)
+		},
+	)
+
+	t.Run(
+		"sets the due date", func(t *testing.T) {
+			stdout, _, card := run(t, "due", "--deck", "Golang Leech", "--card", "1", "--date", "2030-05-01")
+
+			assert.Equal(t, "Card due on 2030-05-01.\n", stdout)
+			assert.Equal(t, time.Date(2030, 5, 1, flashcard.DefaultDayStartsAt, 0, 0, 0, time.Local), card.Due.Local())
+		},
+	)
+
+	t.Run(
+		"sets the due date in the time zone of the deck", func(t *testing.T) {
+			tokyo, err := time.LoadLocation("Asia/Tokyo")
+			require.NoError(t, err)
+			location := test.TempCopyDir(t, leechDeck)
+			repo, err := flashcard.NewRepository(location, clock.New())
+			require.NoError(t, err)
+			deck, err := repo.Find("Golang Leech")
+			require.NoError(t, err)
+			deck.Settings.Timezone = tokyo.String()
+			require.NoError(t, repo.Save(deck))
+
+			_, _, card := runIn(t, location, "due", "--deck", "Golang Leech", "--card", "1", "--date", "2030-05-01")
+
+			assert.Equal(t, time.Date(2030, 5, 1, flashcard.DefaultDayStartsAt, 0, 0, 0, tokyo), card.Due.In(tokyo))
+		},
+	)
+
+	t.Run(
+		"fails when the card does not exist", func(t *testing.T) {
+			_, stderr, card := run(t, "forget", "--deck", "Golang Leech", "--card", "9")
+
+			assert.Contains(t, stderr, `card "9" not found`)
+			assert.Equal(t, fsrs.Review, card.State)
+		},
+	)
+
+	t.Run(
+		"fails when the days are not positive", func(t *testing.T) {
+			_, stderr, _ := run(t, "postpone", "--deck", "Golang Leech", "--days", "0")
+
+			assert.Contains(t, stderr, "days must be greater than zero")
+		},
+	)
+}
+
+func TestCLI_Goal(t *testing.T) {
+	t.Parallel()
+
+	for _, flag := range []string{"cards", "minutes"} {
+		t.Run(
+			"fails when the "+flag+" are negative", func(t *testing.T) {
+				var stdout, stderr bytes.Buffer
+
+				code := tui.CLI([]string{"lembrol", "--decks", t.TempDir(), "--" + flag, "-1"}, &stdout, &stderr)
+
+				assert.Equal(t, -1, code)
+				assert.Contains(t, stderr.String(), flag+" must not be negative")
+			},
+		)
+	}
+}
+
+func TestCLI_Simulate(t *testing.T) {
+	t.Parallel()
+
+	run := func(t *testing.T, args ...string) (string, string) {
+		t.Helper()
+
+		var stdout, stderr bytes.Buffer
+		args = append([]string{"lembrol", "--decks", test.TempCopyDir(t, leechDeck), "simulate", "--deck", "Golang Leech"}, args...)
+
+		tui.CLI(args, &stdout, &stderr)
+
+		return stdout.String(), stderr.String()
+	}
+
+	t.Run(
+		"prints the reviews of each day", func(t *testing.T) {
+			stdout, _ := run(t, "--days", "7", "--retention", "0.95", "--new-per-day", "5")
+
+			lines := strings.Split(strings.TrimSpace(stdout), "\n")
+			require.Len(t, lines, 9)
+			assert.Equal(t, "DATE        REVIEWS  NEW  RETENTION", lines[0])
+			assert.Contains(t, lines[8], "in 7 days")
+		},
+	)
+
+	t.Run(
+		"fails when the retention is invalid", func(t *testing.T) {
+			_, stderr := run(t, "--retention", "1.5")
+
+			assert.Contains(t, stderr, "invalid simulation")
+		},
+	)
+}
+
+func TestCLI_Tags(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"lembrol", "--decks", test.TempCopyDir(t, tagsDeck), "tags", "--deck", "Golang Tags"}
+
+	tui.CLI(args, &stdout, &stderr)
+
+	assert.Empty(t, stderr.String())
+	assert.Equal(
+		t, strings.Join(
+			[]string{
+				"TAG         CARDS  DUE  REVIEWS  RETENTION",
+				"linux       2      2    0        -",
+				"networking  2      2    0        -",
+				"protocols   1      1    0        -",
+				"",
+			}, "\n",
+		), stdout.String(),
+	)
+}
+
+func TestCLI_Media(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"exports and imports the deck with its media", func(t *testing.T) {
+			archive := filepath.Join(t.TempDir(), "diagrams.zip")
+			var stdout, stderr bytes.Buffer
+
+			tui.CLI([]string{"lembrol", "--decks", test.TempCopyDir(t, mediaDeck), "export", "--deck", "Diagrams", "--output", archive}, &stdout, &stderr)
+			require.Empty(t, stderr.String())
+			assert.Equal(t, "Deck Diagrams exported to "+archive+".\n", stdout.String())
+
+			location := t.TempDir()
+			stdout.Reset()
+			tui.CLI([]string{"lembrol", "--decks", location, "import", "--file", archive}, &stdout, &stderr)
+
+			require.Empty(t, stderr.String())
+			assert.Equal(t, "Deck Diagrams imported with 1 card.\n", stdout.String())
+			assert.FileExists(t, filepath.Join(location, "diagrams.json"))
+			assert.FileExists(t, filepath.Join(location, "diagrams.media", "handshake.png"))
+		},
+	)
+
+	t.Run(
+		"lists and deletes the media not used", func(t *testing.T) {
+			location := test.TempCopyDir(t, mediaDeck)
+			var stdout, stderr bytes.Buffer
+
+			tui.CLI([]string{"lembrol", "--decks", location, "media", "--deck", "Diagrams", "--delete"}, &stdout, &stderr)
+
+			require.Empty(t, stderr.String())
+			assert.Equal(t, "orphan.png\n1 file deleted.\n", stdout.String())
+			assert.NoFileExists(t, filepath.Join(location, "diagrams.media", "orphan.png"))
+			assert.FileExists(t, filepath.Join(location, "diagrams.media", "handshake.png"))
+		},
+	)
+}
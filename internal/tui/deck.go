@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -48,6 +49,11 @@ type (
 	deckDeletedMsg struct {
 		list list.Model
 	}
+
+	deckRescheduledMsg struct {
+		list list.Model
+		deck flashcard.Deck
+	}
 )
 
 func showBrowseDeck(model list.Model) tea.Cmd {
@@ -95,6 +101,31 @@ func updateDeck(model list.Model, deck flashcard.Deck, repository Repository) te
 	}
 }
 
+// rescheduleDays is how far the due cards are moved when postponing or advancing a deck.
+const rescheduleDays = 7
+
+func postponeDeck(model list.Model, deck flashcard.Deck, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		deck, _ := deck.Postpone(rescheduleDays)
+		if err := repository.Save(deck); err != nil {
+			return fail(err)
+		}
+
+		return deckRescheduledMsg{list: model, deck: deck}
+	}
+}
+
+func advanceDeck(model list.Model, deck flashcard.Deck, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		deck, _ := deck.Advance(rescheduleDays)
+		if err := repository.Save(deck); err != nil {
+			return fail(err)
+		}
+
+		return deckRescheduledMsg{list: model, deck: deck}
+	}
+}
+
 func deleteDeck(model list.Model, deck flashcard.Deck, repository Repository) tea.Cmd {
 	return func() tea.Msg {
 		if err := repository.Delete(deck); err != nil {
@@ -120,22 +151,70 @@ func hasDueCards(m list.Model) bool {
 	return currentDeck(m).HasDueCards()
 }
 
+// selectedDecks returns the decks selected to be studied together, or all decks when none is selected.
+func selectedDecks(m list.Model) []flashcard.Deck {
+	var selected, all []flashcard.Deck
+	for _, item := range m.Items() {
+		deck := item.(deckItem)
+		all = append(all, deck.Deck)
+		if deck.selected {
+			selected = append(selected, deck.Deck)
+		}
+	}
+
+	if len(selected) == 0 {
+		return all
+	}
+	return selected
+}
+
+func isSelected(m list.Model) bool {
+	item, ok := m.SelectedItem().(deckItem)
+	return ok && item.selected
+}
+
+func hasSelection(m list.Model) bool {
+	for _, item := range m.Items() {
+		if item.(deckItem).selected {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLeeches(m list.Model) bool {
+	for _, item := range m.Items() {
+		if len(item.(deckItem).Leeches()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Deck Item
 
 type deckItem struct {
 	flashcard.Deck
+	selected bool
 }
 
 func (d deckItem) Title() string {
+	if d.selected {
+		return "✓ " + d.Name
+	}
 	return d.Name
 }
 
 func (d deckItem) Description() string {
+	counts := d.DueCounts()
+
 	return fmt.Sprintf(
-		"%d card%s | %d due",
+		"%d card%s | %d new · %d review · %d learning",
 		d.Total(),
 		pluralize(d.Total(), "s"),
-		len(d.DueCards()),
+		counts.New,
+		counts.Review,
+		counts.Learning,
 	)
 }
 
@@ -146,7 +225,7 @@ func (d deckItem) FilterValue() string {
 func newDeckItems(decks []flashcard.Deck) []list.Item {
 	items := make([]list.Item, 0, len(decks))
 	for _, deck := range decks {
-		items = append(items, deckItem{deck})
+		items = append(items, deckItem{Deck: deck})
 	}
 	return items
 }
@@ -154,11 +233,16 @@ func newDeckItems(decks []flashcard.Deck) []list.Item {
 // Browser Deck
 
 type deckBrowseKeyMap struct {
-	add    key.Binding
-	open   key.Binding
-	study  key.Binding
-	edit   key.Binding
-	delete key.Binding
+	add      key.Binding
+	open     key.Binding
+	study    key.Binding
+	edit     key.Binding
+	delete   key.Binding
+	leeches  key.Binding
+	postpone key.Binding
+	advance  key.Binding
+	selected key.Binding
+	studyAll key.Binding
 }
 
 func (k deckBrowseKeyMap) ShortHelp() []key.Binding {
@@ -175,6 +259,11 @@ func (k deckBrowseKeyMap) FullHelp() []key.Binding {
 		k.edit,
 		k.delete,
 		k.study,
+		k.selected,
+		k.studyAll,
+		k.leeches,
+		k.postpone,
+		k.advance,
 	}
 }
 
@@ -205,6 +294,26 @@ func newDeckBrowsePage(shared deckShared) deckBrowsePage {
 				key.WithKeys("x", "delete"),
 				key.WithHelp("x", "delete"),
 			),
+			leeches: key.NewBinding(
+				key.WithKeys("L"),
+				key.WithHelp("L", "leeches"),
+			),
+			postpone: key.NewBinding(
+				key.WithKeys("P"),
+				key.WithHelp("P", "postpone week"),
+			),
+			advance: key.NewBinding(
+				key.WithKeys("A"),
+				key.WithHelp("A", "advance week"),
+			),
+			selected: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "select"),
+			),
+			studyAll: key.NewBinding(
+				key.WithKeys("S"),
+				key.WithHelp("S", "study all"),
+			),
 		},
 	}.checkKeyMap()
 }
@@ -252,6 +361,36 @@ func (m deckBrowsePage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keyMap.open):
 			return m, showCards(0, currentDeck(m.list))
 
+		case key.Matches(msg, m.keyMap.selected):
+			item := m.list.SelectedItem().(deckItem)
+			item.selected = !item.selected
+			cmd = m.list.SetItem(m.list.GlobalIndex(), item)
+			return m.checkKeyMap(), cmd
+
+		case key.Matches(msg, m.keyMap.studyAll):
+			var decks []flashcard.Deck
+			for _, deck := range selectedDecks(m.list) {
+				if deck.HasDueCards() {
+					decks = append(decks, deck)
+				}
+			}
+			return m, startReview(decks...)
+
+		case key.Matches(msg, m.keyMap.leeches):
+			return m, showLeeches
+
+		case key.Matches(msg, m.keyMap.postpone):
+			return m, tea.Batch(
+				showLoading("Decks", "Postponing cards..."),
+				postponeDeck(m.list, currentDeck(m.list), m.repository),
+			)
+
+		case key.Matches(msg, m.keyMap.advance):
+			return m, tea.Batch(
+				showLoading("Decks", "Advancing cards..."),
+				advanceDeck(m.list, currentDeck(m.list), m.repository),
+			)
+
 		case key.Matches(msg, m.list.KeyMap.Quit) && m.list.FilterState() != list.FilterApplied:
 			return m, quit
 		}
@@ -268,6 +407,17 @@ func (m deckBrowsePage) checkKeyMap() deckBrowsePage {
 	m.keyMap.delete.SetEnabled(hasDeck)
 	m.keyMap.edit.SetEnabled(hasDeck)
 	m.keyMap.study.SetEnabled(hasDueCards(m.list))
+	m.keyMap.leeches.SetEnabled(hasLeeches(m.list))
+	m.keyMap.postpone.SetEnabled(hasDeck)
+	m.keyMap.selected.SetEnabled(hasDeck)
+	m.keyMap.selected.SetHelp("space", toggleHelp(isSelected(m.list), "select"))
+	m.keyMap.studyAll.SetEnabled(slices.ContainsFunc(selectedDecks(m.list), flashcard.Deck.HasDueCards))
+	if hasSelection(m.list) {
+		m.keyMap.studyAll.SetHelp("S", "study selected")
+	} else {
+		m.keyMap.studyAll.SetHelp("S", "study all")
+	}
+	m.keyMap.advance.SetEnabled(hasDeck)
 	m.list.NewStatusMessage("")
 	m.list.SetFilteringEnabled(hasDeck)
 	m.list.SetShowStatusBar(hasDeck)
@@ -683,7 +833,7 @@ func (m deckPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case deckCreatedMsg:
 		m.list = msg.list
-		m.list.InsertItem(m.list.Index(), deckItem{msg.deck})
+		m.list.InsertItem(m.list.Index(), deckItem{Deck: msg.deck})
 		m.list.ResetFilter()
 		m.page = newDeckBrowsePage(m.deckShared)
 		return m, nil
@@ -691,11 +841,17 @@ func (m deckPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case deckChangedMsg:
 		m.list = msg.list
 		m.list.RemoveItem(m.list.Index())
-		m.list.InsertItem(m.list.Index()-1, deckItem{msg.deck})
+		m.list.InsertItem(m.list.Index()-1, deckItem{Deck: msg.deck})
 		m.list.ResetFilter()
 		m.page = newDeckBrowsePage(m.deckShared)
 		return m, nil
 
+	case deckRescheduledMsg:
+		m.list = msg.list
+		cmd = m.list.SetItem(m.list.GlobalIndex(), deckItem{Deck: msg.deck})
+		m.page = newDeckBrowsePage(m.deckShared)
+		return m, cmd
+
 	case deckDeletedMsg:
 		m.list = msg.list
 		m.list.RemoveItem(m.list.Index())
@@ -55,9 +55,9 @@ func TestDecksList(t *testing.T) {
 			assert.Contains(t, view, "Decks")
 			assert.Contains(t, view, "6 items")
 			assert.Contains(t, view, activePrompt+"Golang A")
-			assert.Contains(t, view, activePrompt+"6 cards | 3 due")
+			assert.Contains(t, view, activePrompt+"6 cards | 3 new · 0 review · 0 learning")
 			assert.Contains(t, view, "Golang B")
-			assert.Contains(t, view, "3 cards | 0 due")
+			assert.Contains(t, view, "3 cards | 0 new · 0 review · 0 learning")
 			assert.NotContains(t, view, activePrompt+"Golang B")
 			assert.Contains(t, view, "••")
 			assert.Contains(t, view, "↑/k up • ↓/j down • / filter • a add • enter open • q quit • ? more")
@@ -72,11 +72,13 @@ func TestDecksList(t *testing.T) {
 				Get().
 				View()
 
-			assert.Contains(t, view, "↑/k      up             /     filter    q quit")
-			assert.Contains(t, view, "↓/j      down           a     add       ? close help")
+			assert.Contains(t, view, "↑/k      up             /     filter           q quit")
+			assert.Contains(t, view, "↓/j      down           a     add              ? close help")
 			assert.Contains(t, view, "→/l/pgdn next page      enter open")
 			assert.Contains(t, view, "g/home   go to start    x     delete")
 			assert.Contains(t, view, "G/end    go to end      s     study")
+			assert.Contains(t, view, "P     postpone week")
+			assert.Contains(t, view, "A     advance week")
 		},
 	)
 
@@ -188,7 +190,7 @@ func TestDecksList(t *testing.T) {
 
 	t.Run(
 		"does not start review when it does not have due cards", func(t *testing.T) {
-			view := newTestModel(t, manyDecks, tui.WithClock(clock.Clock{Time: oldestCard.LastReview.Add(-time.Hour)})).
+			view := newTestModel(t, manyDecks, tui.WithClock(clock.Clock{Time: oldestCard.LastReview.Add(-24 * time.Hour)})).
 				Init().
 				SendKeyRune(studyKey).
 				Get().
@@ -459,7 +461,7 @@ func TestDeckDelete(t *testing.T) {
 
 			assert.Contains(t, view, "Delete this deck?")
 			assert.Contains(t, view, activePrompt+"Golang B")
-			assert.Contains(t, view, activePrompt+"2 cards | 2 due")
+			assert.Contains(t, view, activePrompt+"2 cards | 2 new · 0 review · 0 learning")
 			assert.Contains(t, view, "enter confirm • esc cancel")
 		},
 	)
@@ -587,3 +589,92 @@ func TestDeckDelete(t *testing.T) {
 		},
 	)
 }
+
+func TestDeckReschedule(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"postpones the due cards", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune("P").
+				Get().
+				View()
+
+			assert.Contains(t, view, activePrompt+"3 cards | 0 new · 0 review · 0 learning")
+		},
+	)
+
+	t.Run(
+		"advances the cards due in the next days", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune("A").
+				Get().
+				View()
+
+			assert.Contains(t, view, activePrompt+"3 cards | 0 new · 2 review · 0 learning")
+		},
+	)
+}
+
+func TestDeckStudyAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"studies the due cards of all decks", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune("S").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Question")
+			assert.Contains(t, view, "1 of 8")
+		},
+	)
+
+	t.Run(
+		"marks the selected decks", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyType(tea.KeySpace).
+				SendKeyRune(helpKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, activePrompt+"✓ Golang A")
+			assert.Contains(t, view, "space unselect")
+			assert.Contains(t, view, "S     study selected")
+		},
+	)
+
+	t.Run(
+		"studies the due cards of the selected decks", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune(keyDown).
+				SendKeyType(tea.KeySpace).
+				SendKeyRune("S").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang B")
+			assert.Contains(t, view, "1 of 2")
+		},
+	)
+
+	t.Run(
+		"goes back to the decks when the review is canceled", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune("S").
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Decks")
+			assert.Contains(t, view, "2 items")
+		},
+	)
+}
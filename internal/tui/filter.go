@@ -0,0 +1,527 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+// Messages
+
+type (
+	showBrowseFilterMsg struct {
+		list list.Model
+	}
+
+	showAddFilterMsg struct {
+		list list.Model
+	}
+
+	filterSavedMsg struct {
+		list list.Model
+		deck flashcard.Deck
+	}
+)
+
+func showBrowseFilter(model list.Model) tea.Cmd {
+	return func() tea.Msg {
+		return showBrowseFilterMsg{list: model}
+	}
+}
+
+func showAddFilter(model list.Model) tea.Cmd {
+	return func() tea.Msg {
+		return showAddFilterMsg{list: model}
+	}
+}
+
+func saveFilters(model list.Model, deck flashcard.Deck, filters []flashcard.Filter, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		deck.Filters = filters
+		if err := repository.Save(deck); err != nil {
+			return fail(err)
+		}
+
+		return filterSavedMsg{list: model, deck: deck}
+	}
+}
+
+func currentFilter(m list.Model) (filterItem, bool) {
+	item, ok := m.SelectedItem().(filterItem)
+	return item, ok
+}
+
+// Filter Item
+
+type filterItem struct {
+	flashcard.Filter
+	total int
+}
+
+func (f filterItem) Title() string {
+	return f.Name
+}
+
+func (f filterItem) Description() string {
+	query := f.Query
+	if query == "" {
+		query = "all cards"
+	}
+
+	description := fmt.Sprintf("%d card%s | %s", f.total, pluralize(f.total, "s"), query)
+	if f.Limit > 0 {
+		description += fmt.Sprintf(" | up to %d", f.Limit)
+	}
+	if f.Order != "" {
+		description += " | " + strings.ReplaceAll(string(f.Order), "_", " ")
+	}
+	if f.Cram {
+		description += " | cram"
+	}
+	return description
+}
+
+func (f filterItem) FilterValue() string {
+	return f.Name
+}
+
+func newFilterItems(deck flashcard.Deck) []list.Item {
+	items := make([]list.Item, 0, len(deck.Filters))
+	for _, filter := range deck.Filters {
+		var total int
+		if query, err := flashcard.ParseQuery(filter.Query); err == nil {
+			total = len(deck.Search(query))
+			if filter.Limit > 0 {
+				total = min(total, filter.Limit)
+			}
+		}
+		items = append(items, filterItem{Filter: filter, total: total})
+	}
+	return items
+}
+
+func filtersOf(m list.Model) []flashcard.Filter {
+	filters := make([]flashcard.Filter, 0, len(m.Items()))
+	for _, item := range m.Items() {
+		filters = append(filters, item.(filterItem).Filter)
+	}
+	return filters
+}
+
+// Browse Filter
+
+type filterBrowseKeyMap struct {
+	add    key.Binding
+	study  key.Binding
+	delete key.Binding
+}
+
+func (k filterBrowseKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.add,
+		k.study,
+	}
+}
+
+func (k filterBrowseKeyMap) FullHelp() []key.Binding {
+	return []key.Binding{
+		k.add,
+		k.study,
+		k.delete,
+	}
+}
+
+func newFilterBrowsePage(shared filterShared) filterBrowsePage {
+	shared.list.SetSize(shared.width-shared.styles.List.GetHorizontalFrameSize(), shared.height-shared.styles.List.GetVerticalFrameSize())
+
+	return filterBrowsePage{
+		filterShared: shared,
+		keyMap: filterBrowseKeyMap{
+			add: key.NewBinding(
+				key.WithKeys("a"),
+				key.WithHelp("a", "add"),
+			),
+			study: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "study"),
+			),
+			delete: key.NewBinding(
+				key.WithKeys("x", "delete"),
+				key.WithHelp("x", "delete"),
+			),
+		},
+	}.checkKeyMap()
+}
+
+type filterBrowsePage struct {
+	filterShared
+	keyMap filterBrowseKeyMap
+}
+
+func (m filterBrowsePage) Init() tea.Cmd {
+	m.Log("filter-browse: init")
+	return nil
+}
+
+func (m filterBrowsePage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("filterBrowse update: %T", msg)
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width, msg.Height-m.styles.List.GetVerticalPadding())
+		return m, nil
+
+	case tea.KeyMsg:
+		// Don't match any of the keys below if we're actively filtering.
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, m.keyMap.add):
+			return m, showAddFilter(m.list)
+
+		case key.Matches(msg, m.keyMap.study):
+			item, _ := currentFilter(m.list)
+			return m, startFilteredReview(item.Filter, m.deck)
+
+		case key.Matches(msg, m.keyMap.delete):
+			m.list.RemoveItem(m.list.GlobalIndex())
+			return m, tea.Batch(
+				showLoading("Filters", "Deleting filter..."),
+				saveFilters(m.list, m.deck, filtersOf(m.list), m.repository),
+			)
+
+		case key.Matches(msg, m.list.KeyMap.Quit) && m.list.FilterState() != list.FilterApplied:
+			return m, showCards(0, m.deck)
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m.checkKeyMap(), cmd
+}
+
+func (m filterBrowsePage) checkKeyMap() filterBrowsePage {
+	item, ok := currentFilter(m.list)
+	m.keyMap.add.SetEnabled(m.list.FilterState() == list.Unfiltered)
+	m.keyMap.study.SetEnabled(ok && item.total > 0)
+	m.keyMap.delete.SetEnabled(ok)
+	m.list.SetFilteringEnabled(ok)
+	m.list.SetShowStatusBar(ok)
+	m.list.AdditionalShortHelpKeys = m.keyMap.ShortHelp
+	m.list.AdditionalFullHelpKeys = m.keyMap.FullHelp
+	return m
+}
+
+func (m filterBrowsePage) View() string {
+	m.Log("filterBrowse view: width=%d height=%d", m.width, m.height)
+
+	return m.styles.List.Render(m.list.View())
+}
+
+// Add Filter
+
+type filterFormKeyMap struct {
+	confirm  key.Binding
+	cancel   key.Binding
+	cram     key.Binding
+	previous key.Binding
+	next     key.Binding
+}
+
+func (k filterFormKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.next,
+		k.previous,
+		k.cram,
+		k.confirm,
+		k.cancel,
+	}
+}
+
+func (k filterFormKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+func newFilterAddPage(shared filterShared) filterAddPage {
+	newInput := func(placeholder string) textinput.Model {
+		input := textinput.New()
+		input.Prompt = "┃ "
+		input.Placeholder = placeholder
+		input.CharLimit = 100
+		return input
+	}
+
+	inputs := []textinput.Model{
+		newInput("Name, like Failed today"),
+		newInput("Query, like failed:1 or is:new or unreviewed:90"),
+		newInput("Maximum number of cards, empty for all"),
+		newInput("Order, like due or retrievability, empty for the deck order"),
+	}
+	inputs[0].Focus()
+
+	return filterAddPage{
+		filterShared: shared,
+		inputs:       inputs,
+		cursor:       newCursor(len(inputs) - 1),
+		keyMap: filterFormKeyMap{
+			confirm: key.NewBinding(
+				key.WithKeys("ctrl+s"),
+				key.WithHelp("ctrl+s", "confirm"),
+			),
+			cancel: key.NewBinding(
+				key.WithKeys("ctrl+c"),
+				key.WithHelp("ctrl+c", "cancel"),
+			),
+			cram: key.NewBinding(
+				key.WithKeys("ctrl+r"),
+				key.WithHelp("ctrl+r", "cram"),
+			),
+			previous: key.NewBinding(
+				key.WithKeys("shift+tab"),
+				key.WithHelp("shift+tab", "up"),
+			),
+			next: key.NewBinding(
+				key.WithKeys("tab"),
+				key.WithHelp("tab", "down"),
+			),
+		},
+	}
+}
+
+type filterAddPage struct {
+	filterShared
+	inputs []textinput.Model
+	cursor cursor
+	cram   bool
+	keyMap filterFormKeyMap
+}
+
+func (m filterAddPage) Init() tea.Cmd {
+	m.Log("filter-add: init")
+
+	return textinput.Blink
+}
+
+// filter returns the filter defined in the form or the reason it is not valid.
+func (m filterAddPage) filter() (flashcard.Filter, error) {
+	filter := flashcard.Filter{
+		Name:  strings.TrimSpace(m.inputs[0].Value()),
+		Query: strings.TrimSpace(m.inputs[1].Value()),
+		Cram:  m.cram,
+	}
+
+	if filter.Name == "" {
+		return filter, fmt.Errorf("missing name")
+	}
+
+	if _, err := flashcard.ParseQuery(filter.Query); err != nil {
+		return filter, err
+	}
+
+	if limit := strings.TrimSpace(m.inputs[2].Value()); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 0 {
+			return filter, fmt.Errorf("invalid limit %q", limit)
+		}
+		filter.Limit = value
+	}
+
+	if order := strings.TrimSpace(m.inputs[3].Value()); order != "" {
+		value, err := flashcard.ParseReviewOrder(order)
+		if err != nil {
+			return filter, err
+		}
+		filter.Order = value
+	}
+
+	return filter, nil
+}
+
+func (m filterAddPage) focus(index int) (filterAddPage, tea.Cmd) {
+	var cmd tea.Cmd
+
+	for i := range m.inputs {
+		if i == index {
+			cmd = m.inputs[i].Focus()
+		} else {
+			m.inputs[i].Blur()
+		}
+	}
+
+	return m, cmd
+}
+
+func (m filterAddPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("filterAdd update: %T", msg)
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.cancel):
+			return m, showBrowseFilter(m.list)
+
+		case key.Matches(msg, m.keyMap.cram):
+			m.cram = !m.cram
+			return m, nil
+
+		case key.Matches(msg, m.keyMap.previous):
+			m.cursor.Up()
+			return m.focus(m.cursor.Value())
+
+		case key.Matches(msg, m.keyMap.next):
+			m.cursor.Down()
+			return m.focus(m.cursor.Value())
+
+		case key.Matches(msg, m.keyMap.confirm):
+			filter, err := m.filter()
+			if err != nil {
+				return m, nil
+			}
+
+			return m, tea.Batch(
+				showLoading("Filters", "Saving filter..."),
+				saveFilters(m.list, m.deck, append(filtersOf(m.list), filter), m.repository),
+			)
+		}
+	}
+
+	var cmd tea.Cmd
+	index := m.cursor.Value()
+	m.inputs[index], cmd = m.inputs[index].Update(msg)
+	return m, cmd
+}
+
+func (m filterAddPage) View() string {
+	m.Log("filterAdd view: width=%d height=%d", m.width, m.height)
+
+	header := m.styles.Title.
+		Margin(2, 0, 0, 2).
+		Render("Filters")
+
+	subTitle := m.styles.DimmedTitle.
+		Margin(1, 0, 1, 2).
+		Render("Add")
+
+	fields := make([]string, 0, len(m.inputs)+2)
+	for _, input := range m.inputs {
+		fields = append(fields, input.View())
+	}
+
+	cram := "Cram off: the answers change the schedule"
+	if m.cram {
+		cram = "Cram on: the answers do not change the schedule"
+	}
+	fields = append(fields, "", cram)
+
+	if _, err := m.filter(); err != nil {
+		fields = append(fields, m.styles.DeletedStatus.Render(err.Error()))
+	}
+
+	footer := lipgloss.
+		NewStyle().
+		Width(m.width).
+		Padding(0, 2, 1).
+		Render(renderHelp(m.keyMap, m.width, false))
+
+	form := m.styles.Text.
+		Height(m.height-lipgloss.Height(header)-lipgloss.Height(subTitle)-lipgloss.Height(footer)).
+		Margin(0, 2).
+		Render(lipgloss.JoinVertical(lipgloss.Left, fields...))
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, form, footer)
+}
+
+// Filter Page
+
+type filterShared struct {
+	Shared
+	deck flashcard.Deck
+	list list.Model
+}
+
+func newFilterPage(parent Shared, deck flashcard.Deck) filterPage {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles.SelectedTitle = parent.styles.SelectedTitle
+	delegate.Styles.SelectedDesc = parent.styles.SelectedDesc
+
+	shared := filterShared{
+		Shared: parent,
+		deck:   deck,
+		list:   list.New(newFilterItems(deck), delegate, parent.width, parent.height),
+	}
+	shared.list.Title = deck.Name + " Filters"
+	shared.list.Styles.NoItems = shared.list.Styles.NoItems.Copy().Margin(0, 2)
+
+	return filterPage{
+		filterShared: shared,
+		page:         newFilterBrowsePage(shared),
+	}
+}
+
+type filterPage struct {
+	filterShared
+	page tea.Model
+}
+
+func (m filterPage) Init() tea.Cmd {
+	m.Log("filter: init")
+
+	return m.page.Init()
+}
+
+func (m filterPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("filter update: %T", msg)
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case showLoadingMsg:
+		m.page = newLoadingPage(m.Shared, msg.title, msg.description)
+		return m, m.page.Init()
+
+	case showBrowseFilterMsg:
+		m.list = msg.list
+		m.page = newFilterBrowsePage(m.filterShared)
+		return m, nil
+
+	case showAddFilterMsg:
+		m.list = msg.list
+		m.page = newFilterAddPage(m.filterShared)
+		return m, m.page.Init()
+
+	case filterSavedMsg:
+		m.deck = msg.deck
+		m.list = msg.list
+		cmd = m.list.SetItems(newFilterItems(m.deck))
+		m.list.Select(len(m.list.Items()) - 1)
+		m.page = newFilterBrowsePage(m.filterShared)
+		return m, cmd
+	}
+
+	m.page, cmd = m.page.Update(msg)
+	return m, cmd
+}
+
+func (m filterPage) View() string {
+	m.Log("filter view: width=%d height=%d", m.width, m.height)
+
+	return m.page.View()
+}
@@ -0,0 +1,147 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	clock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/tui"
+)
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+
+	// addFilter opens the filters of the leech deck and fills the add form.
+	addFilter := func(t *testing.T, name, query string) *testModel {
+		return newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(filtersKey).
+			SendKeyRune(createKey).
+			SendKeyRune(name).
+			SendKeyType(tea.KeyTab).
+			SendKeyRune(query)
+	}
+
+	t.Run(
+		"shows the deck without filters", func(t *testing.T) {
+			view := newTestModel(t, leechDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(filtersKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang Leech Filters")
+			assert.Contains(t, view, "No items.")
+		},
+	)
+
+	t.Run(
+		"saves the filter", func(t *testing.T) {
+			view := addFilter(t, "Leeches", "is:leech").
+				SendKeyRune(cramKey).
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "1 item")
+			assert.Contains(t, view, activePrompt+"Leeches")
+			assert.Contains(t, view, "1 card | is:leech | cram")
+		},
+	)
+
+	t.Run(
+		"saves the filter order", func(t *testing.T) {
+			view := addFilter(t, "Hardest", "is:review").
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("difficulty").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "3 cards | is:review | difficulty")
+		},
+	)
+
+	t.Run(
+		"does not save an invalid order", func(t *testing.T) {
+			view := addFilter(t, "Sorted", "").
+				SendKeyType(tea.KeyTab).
+				SendKeyType(tea.KeyTab).
+				SendKeyRune("alphabetical").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, `invalid order "alphabetical"`)
+		},
+	)
+
+	t.Run(
+		"does not save an invalid filter", func(t *testing.T) {
+			view := addFilter(t, "Broken", "is:unknown").
+				SendKeyRune(saveKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Add")
+			assert.Contains(t, view, `unknown state "unknown"`)
+		},
+	)
+
+	t.Run(
+		"cancels the filter", func(t *testing.T) {
+			view := addFilter(t, "Leeches", "is:leech").
+				SendKeyRune(cancelKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "No items.")
+		},
+	)
+
+	t.Run(
+		"studies the cards of the filter", func(t *testing.T) {
+			view := addFilter(t, "Everything", "question").
+				SendKeyRune(cramKey).
+				SendKeyRune(saveKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang Leech")
+			assert.Contains(t, view, "1 of 3 • cram")
+		},
+	)
+
+	t.Run(
+		"deletes the filter", func(t *testing.T) {
+			view := addFilter(t, "Leeches", "is:leech").
+				SendKeyRune(saveKey).
+				SendKeyRune(deleteKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "No items.")
+		},
+	)
+
+	t.Run(
+		"returns to the cards", func(t *testing.T) {
+			view := newTestModel(t, leechDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(filtersKey).
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang Leech")
+			assert.Contains(t, view, "3 items")
+		},
+	)
+}
@@ -0,0 +1,133 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+// Leech Item
+
+type leechItem struct {
+	flashcard.Card
+	deck  flashcard.Deck
+	index int
+}
+
+func (l leechItem) Title() string {
+	return l.Front()
+}
+
+func (l leechItem) Description() string {
+	return fmt.Sprintf("%s | forgotten %d time%s", l.deck.Name, l.Lapses, pluralize(int(l.Lapses), "s"))
+}
+
+func (l leechItem) FilterValue() string {
+	return l.Question
+}
+
+// newLeechItems lists the leeches of all decks keeping the position of each card in its deck.
+func newLeechItems(decks []flashcard.Deck) []list.Item {
+	var items []list.Item
+	for _, deck := range decks {
+		for _, leech := range deck.Leeches() {
+			for index, card := range deck.List() {
+				if card.ID == leech.ID {
+					items = append(items, leechItem{Card: leech, deck: deck, index: index})
+					break
+				}
+			}
+		}
+	}
+	return items
+}
+
+// Leech Page
+
+type leechKeyMap struct {
+	open key.Binding
+}
+
+func (k leechKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.open}
+}
+
+func (k leechKeyMap) FullHelp() []key.Binding {
+	return []key.Binding{k.open}
+}
+
+func newLeechPage(shared Shared) leechPage {
+	delegate := list.NewDefaultDelegate()
+	delegate.Styles.SelectedTitle = shared.styles.SelectedTitle
+	delegate.Styles.SelectedDesc = shared.styles.SelectedDesc
+
+	keyMap := leechKeyMap{
+		open: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "open"),
+		),
+	}
+
+	model := list.New(newLeechItems(shared.repository.List()), delegate, shared.width, shared.height)
+	model.SetSize(shared.width-shared.styles.List.GetHorizontalFrameSize(), shared.height-shared.styles.List.GetVerticalFrameSize())
+	model.Title = "Leeches"
+	model.Styles.NoItems = model.Styles.NoItems.Copy().Margin(0, 2)
+	model.AdditionalShortHelpKeys = keyMap.ShortHelp
+	model.AdditionalFullHelpKeys = keyMap.FullHelp
+
+	return leechPage{Shared: shared, list: model, keyMap: keyMap}
+}
+
+type leechPage struct {
+	Shared
+	list   list.Model
+	keyMap leechKeyMap
+}
+
+func (m leechPage) Init() tea.Cmd {
+	m.Log("leech: init")
+
+	return nil
+}
+
+func (m leechPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("leech update: %T", msg)
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.list.SetSize(msg.Width, msg.Height-m.styles.List.GetVerticalPadding())
+		return m, nil
+
+	case tea.KeyMsg:
+		// Don't match any of the keys below if we're actively filtering.
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch {
+		case key.Matches(msg, m.keyMap.open):
+			if item, ok := m.list.SelectedItem().(leechItem); ok {
+				return m, showCards(item.index, item.deck)
+			}
+
+		case key.Matches(msg, m.list.KeyMap.Quit) && m.list.FilterState() != list.FilterApplied:
+			return m, showDecks(0)
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m leechPage) View() string {
+	m.Log("leech view: width=%d height=%d", m.width, m.height)
+
+	return m.styles.List.Render(m.list.View())
+}
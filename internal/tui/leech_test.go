@@ -0,0 +1,92 @@
+package tui_test
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	clock "github.com/eliostvs/lembrol/internal/clock/test"
+	"github.com/eliostvs/lembrol/internal/tui"
+)
+
+// leechTime is when only the leech of the leech deck is due.
+var leechTime = time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+
+func TestLeeches(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"lists the leeches across the decks", func(t *testing.T) {
+			view := newTestModel(t, leechDeck).
+				Init().
+				SendKeyRune(leechesKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Leeches")
+			assert.Contains(t, view, "1 item")
+			assert.Contains(t, view, activePrompt+"Question A")
+			assert.Contains(t, view, "Golang Leech | forgotten 9 times")
+			assert.NotContains(t, view, "Question B")
+		},
+	)
+
+	t.Run(
+		"is disabled when there are no leeches", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune(leechesKey).
+				SendKeyRune(helpKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Decks")
+			assert.NotContains(t, view, "leeches")
+		},
+	)
+
+	t.Run(
+		"opens the leech in its deck", func(t *testing.T) {
+			view := newTestModel(t, leechDeck).
+				Init().
+				SendKeyRune(leechesKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang Leech")
+			assert.Contains(t, view, activePrompt+"Question A")
+			assert.Contains(t, view, "• leech")
+		},
+	)
+
+	t.Run(
+		"goes back to the decks", func(t *testing.T) {
+			view := newTestModel(t, leechDeck).
+				Init().
+				SendKeyRune(leechesKey).
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Decks")
+			assert.Contains(t, view, "1 item")
+		},
+	)
+
+	t.Run(
+		"shows the leech notice in the answer", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Answer A")
+			assert.Contains(t, view, "1 of 1 • leech, forgotten 9 times")
+		},
+	)
+}
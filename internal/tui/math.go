@@ -0,0 +1,461 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// errMath is returned when the math cannot be converted, which is then shown as written.
+var errMath = errors.New("unsupported math")
+
+var mathSymbols = map[string]string{
+	// Greek letters
+	"alpha": "α", "beta": "β", "gamma": "γ", "delta": "δ", "epsilon": "ϵ", "varepsilon": "ε", "zeta": "ζ",
+	"eta": "η", "theta": "θ", "vartheta": "ϑ", "iota": "ι", "kappa": "κ", "lambda": "λ", "mu": "μ", "nu": "ν",
+	"xi": "ξ", "pi": "π", "varpi": "ϖ", "rho": "ρ", "varrho": "ϱ", "sigma": "σ", "varsigma": "ς", "tau": "τ",
+	"upsilon": "υ", "phi": "ϕ", "varphi": "φ", "chi": "χ", "psi": "ψ", "omega": "ω",
+	"Gamma": "Γ", "Delta": "Δ", "Theta": "Θ", "Lambda": "Λ", "Xi": "Ξ", "Pi": "Π", "Sigma": "Σ",
+	"Upsilon": "Υ", "Phi": "Φ", "Psi": "Ψ", "Omega": "Ω",
+	// operators and relations
+	"times": "×", "cdot": "·", "div": "÷", "pm": "±", "mp": "∓", "ast": "∗", "circ": "∘", "bullet": "∙",
+	"leq": "≤", "le": "≤", "geq": "≥", "ge": "≥", "neq": "≠", "ne": "≠", "approx": "≈", "equiv": "≡",
+	"sim": "∼", "simeq": "≃", "cong": "≅", "propto": "∝", "ll": "≪", "gg": "≫", "mid": "∣",
+	"sum": "∑", "prod": "∏", "int": "∫", "iint": "∬", "oint": "∮", "partial": "∂", "nabla": "∇",
+	"infty": "∞", "emptyset": "∅", "varnothing": "∅", "forall": "∀", "exists": "∃", "neg": "¬", "lnot": "¬",
+	"land": "∧", "wedge": "∧", "lor": "∨", "vee": "∨", "oplus": "⊕", "otimes": "⊗",
+	"in": "∈", "notin": "∉", "ni": "∋", "subset": "⊂", "subseteq": "⊆", "supset": "⊃", "supseteq": "⊇",
+	"cup": "∪", "cap": "∩", "setminus": "∖",
+	"to": "→", "rightarrow": "→", "leftarrow": "←", "gets": "←", "leftrightarrow": "↔", "Rightarrow": "⇒",
+	"implies": "⇒", "Leftarrow": "⇐", "Leftrightarrow": "⇔", "iff": "⇔", "mapsto": "↦",
+	"uparrow": "↑", "downarrow": "↓",
+	"ldots": "…", "dots": "…", "cdots": "⋯", "vdots": "⋮", "ddots": "⋱",
+	"angle": "∠", "perp": "⊥", "parallel": "∥", "triangle": "△", "degree": "°", "prime": "′", "hbar": "ℏ",
+	"ell": "ℓ", "Re": "ℜ", "Im": "ℑ", "aleph": "ℵ",
+	"lfloor": "⌊", "rfloor": "⌋", "lceil": "⌈", "rceil": "⌉", "langle": "⟨", "rangle": "⟩",
+	"vert": "|", "lvert": "|", "rvert": "|", "Vert": "‖", "lVert": "‖", "rVert": "‖",
+	// spacing
+	"quad": "  ", "qquad": "    ", ",": " ", ";": " ", ":": " ", "!": "", " ": " ",
+	// escaped characters
+	"{": "{", "}": "}", "%": "%", "$": "$", "&": "&", "#": "#", "_": "_", "\\": " ",
+}
+
+// mathFunctions are written upright, as their name.
+var mathFunctions = []string{
+	"sin", "cos", "tan", "cot", "sec", "csc", "arcsin", "arccos", "arctan", "sinh", "cosh", "tanh",
+	"log", "ln", "lg", "exp", "lim", "max", "min", "sup", "inf", "det", "gcd", "deg", "dim", "ker", "arg", "mod",
+}
+
+var mathBlackboard = map[rune]string{
+	'N': "ℕ", 'Z': "ℤ", 'Q': "ℚ", 'R': "ℝ", 'C': "ℂ", 'P': "ℙ", 'H': "ℍ", 'E': "𝔼",
+}
+
+// mathAccents are combining characters placed over the previous character.
+var mathAccents = map[string]string{
+	"hat": "̂", "bar": "̄", "overline": "̅", "vec": "⃗", "dot": "̇", "ddot": "̈",
+	"tilde": "̃",
+}
+
+var superscripts = map[rune]rune{
+	'0': '⁰', '1': '¹', '2': '²', '3': '³', '4': '⁴', '5': '⁵', '6': '⁶', '7': '⁷', '8': '⁸', '9': '⁹',
+	'+': '⁺', '-': '⁻', '−': '⁻', '=': '⁼', '(': '⁽', ')': '⁾', '∘': '°', '′': '′', '*': '*',
+	'a': 'ᵃ', 'b': 'ᵇ', 'c': 'ᶜ', 'd': 'ᵈ', 'e': 'ᵉ', 'f': 'ᶠ', 'g': 'ᵍ', 'h': 'ʰ', 'i': 'ⁱ', 'j': 'ʲ',
+	'k': 'ᵏ', 'l': 'ˡ', 'm': 'ᵐ', 'n': 'ⁿ', 'o': 'ᵒ', 'p': 'ᵖ', 'r': 'ʳ', 's': 'ˢ', 't': 'ᵗ', 'u': 'ᵘ',
+	'v': 'ᵛ', 'w': 'ʷ', 'x': 'ˣ', 'y': 'ʸ', 'z': 'ᶻ',
+	'A': 'ᴬ', 'B': 'ᴮ', 'D': 'ᴰ', 'E': 'ᴱ', 'G': 'ᴳ', 'H': 'ᴴ', 'I': 'ᴵ', 'J': 'ᴶ', 'K': 'ᴷ', 'L': 'ᴸ',
+	'M': 'ᴹ', 'N': 'ᴺ', 'O': 'ᴼ', 'P': 'ᴾ', 'R': 'ᴿ', 'T': 'ᵀ', 'U': 'ᵁ', 'V': 'ⱽ', 'W': 'ᵂ',
+	'β': 'ᵝ', 'γ': 'ᵞ', 'δ': 'ᵟ', 'θ': 'ᶿ', 'φ': 'ᵠ', 'χ': 'ᵡ',
+}
+
+var subscripts = map[rune]rune{
+	'0': '₀', '1': '₁', '2': '₂', '3': '₃', '4': '₄', '5': '₅', '6': '₆', '7': '₇', '8': '₈', '9': '₉',
+	'+': '₊', '-': '₋', '−': '₋', '=': '₌', '(': '₍', ')': '₎',
+	'a': 'ₐ', 'e': 'ₑ', 'h': 'ₕ', 'i': 'ᵢ', 'j': 'ⱼ', 'k': 'ₖ', 'l': 'ₗ', 'm': 'ₘ', 'n': 'ₙ', 'o': 'ₒ',
+	'p': 'ₚ', 'r': 'ᵣ', 's': 'ₛ', 't': 'ₜ', 'u': 'ᵤ', 'v': 'ᵥ', 'x': 'ₓ',
+	'β': 'ᵦ', 'γ': 'ᵧ', 'ρ': 'ᵨ', 'φ': 'ᵩ', 'χ': 'ᵪ',
+}
+
+var vulgarFractions = map[string]string{
+	"1/2": "½", "1/3": "⅓", "2/3": "⅔", "1/4": "¼", "3/4": "¾", "1/5": "⅕", "2/5": "⅖", "3/5": "⅗",
+	"4/5": "⅘", "1/6": "⅙", "5/6": "⅚", "1/7": "⅐", "1/8": "⅛", "3/8": "⅜", "5/8": "⅝", "7/8": "⅞",
+	"1/9": "⅑", "1/10": "⅒",
+}
+
+// RenderMath replaces the math of the text, written in LaTeX between $ or $$, by a Unicode representation
+// readable in the terminal, like $\frac{1}{2}\sqrt{x^2+1}$ as ½√(x²+1).
+// The code spans and blocks are left untouched, and so is the math that cannot be converted.
+func RenderMath(text string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(text); {
+		switch {
+		case text[i] == '\\' && i+1 < len(text):
+			b.WriteString(text[i : i+2])
+			i += 2
+
+		case text[i] == '`':
+			end := codeEnd(text, i)
+			b.WriteString(text[i:end])
+			i = end
+
+		case strings.HasPrefix(text[i:], "$$"):
+			end := strings.Index(text[i+2:], "$$")
+			if end < 0 {
+				b.WriteString("$$")
+				i += 2
+				continue
+			}
+			b.WriteString(convertMath(text[i:i+end+4], text[i+2:i+2+end]))
+			i += end + 4
+
+		case text[i] == '$':
+			end := inlineMathEnd(text, i)
+			if end < 0 {
+				b.WriteByte('$')
+				i++
+				continue
+			}
+			b.WriteString(convertMath(text[i:end+1], text[i+1:end]))
+			i = end + 1
+
+		default:
+			b.WriteByte(text[i])
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+// codeEnd returns the end of the code span, or code block, starting at i, closed by the same number of backticks.
+func codeEnd(text string, i int) int {
+	ticks := i
+	for ticks < len(text) && text[ticks] == '`' {
+		ticks++
+	}
+
+	fence := text[i:ticks]
+	for j := ticks; j < len(text); {
+		k := strings.Index(text[j:], fence)
+		if k < 0 {
+			break
+		}
+		k += j
+		end := k + len(fence)
+		if end == len(text) || text[end] != '`' {
+			return end
+		}
+		for end < len(text) && text[end] == '`' {
+			end++
+		}
+		j = end
+	}
+	return ticks
+}
+
+// inlineMathEnd returns the index of the $ closing the inline math opened at i, or -1.
+// As in Pandoc, the math cannot start or end with a space, and the closing $ cannot be followed
+// by a digit, so prices like $5 and $10 are not taken as math.
+func inlineMathEnd(text string, i int) int {
+	if i+1 >= len(text) || text[i+1] == ' ' || text[i+1] == '\n' {
+		return -1
+	}
+
+	for j := i + 1; j < len(text); j++ {
+		switch {
+		case text[j] == '\\':
+			j++
+		case text[j] == '\n' && j+1 < len(text) && text[j+1] == '\n':
+			return -1
+		case text[j] == '$' && text[j-1] != ' ' && (j+1 == len(text) || !unicode.IsDigit(rune(text[j+1]))):
+			return j
+		}
+	}
+	return -1
+}
+
+// convertMath converts the LaTeX math, or returns the raw text when it cannot be converted.
+func convertMath(raw, math string) string {
+	p := mathParser{input: []rune(math)}
+	converted, err := p.parse(false)
+	if err != nil || p.pos < len(p.input) {
+		return raw
+	}
+	return strings.TrimSpace(converted)
+}
+
+type mathParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *mathParser) peek() (rune, bool) {
+	if p.pos >= len(p.input) {
+		return 0, false
+	}
+	return p.input[p.pos], true
+}
+
+func (p *mathParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+// parse converts the math up to the end of the input, or to the closing brace of a group.
+func (p *mathParser) parse(group bool) (string, error) {
+	var b strings.Builder
+
+	for {
+		r, ok := p.peek()
+		switch {
+		case !ok && group:
+			return "", fmt.Errorf("%w: missing }", errMath)
+
+		case !ok:
+			return b.String(), nil
+
+		case r == '}' && group:
+			p.pos++
+			return b.String(), nil
+
+		case r == '}':
+			return "", fmt.Errorf("%w: unexpected }", errMath)
+
+		case unicode.IsSpace(r):
+			p.skipSpaces()
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), " ") {
+				b.WriteByte(' ')
+			}
+
+		case r == '^' || r == '_':
+			p.pos++
+			arg, err := p.argument()
+			if err != nil {
+				return "", err
+			}
+			if r == '^' {
+				b.WriteString(script(arg, superscripts, "^"))
+			} else {
+				b.WriteString(script(arg, subscripts, "_"))
+			}
+
+		default:
+			s, err := p.atom()
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(s)
+		}
+	}
+}
+
+// atom converts the next group, command or character.
+func (p *mathParser) atom() (string, error) {
+	r, _ := p.peek()
+	switch r {
+	case '{':
+		p.pos++
+		return p.parse(true)
+	case '\\':
+		p.pos++
+		return p.command()
+	}
+
+	p.pos++
+	return string(r), nil
+}
+
+// argument converts the argument of a command or script, a group or a single atom.
+func (p *mathParser) argument() (string, error) {
+	p.skipSpaces()
+	if _, ok := p.peek(); !ok {
+		return "", fmt.Errorf("%w: missing argument", errMath)
+	}
+	return p.atom()
+}
+
+// rawArgument returns the text of the group argument as written, like the text of \text{...}.
+func (p *mathParser) rawArgument() (string, error) {
+	p.skipSpaces()
+	if r, ok := p.peek(); !ok || r != '{' {
+		return "", fmt.Errorf("%w: missing argument", errMath)
+	}
+
+	depth := 0
+	for start := p.pos; p.pos < len(p.input); p.pos++ {
+		switch p.input[p.pos] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return string(p.input[start+1 : p.pos-1]), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: missing }", errMath)
+}
+
+func (p *mathParser) commandName() string {
+	start := p.pos
+	for p.pos < len(p.input) && unicode.IsLetter(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start && p.pos < len(p.input) {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+//nolint:cyclop
+func (p *mathParser) command() (string, error) {
+	name := p.commandName()
+
+	if symbol, ok := mathSymbols[name]; ok {
+		return symbol, nil
+	}
+
+	for _, function := range mathFunctions {
+		if name == function {
+			return name, nil
+		}
+	}
+
+	if accent, ok := mathAccents[name]; ok {
+		arg, err := p.argument()
+		if err != nil {
+			return "", err
+		}
+		return arg + accent, nil
+	}
+
+	switch name {
+	case "frac", "dfrac", "tfrac":
+		numerator, err := p.argument()
+		if err != nil {
+			return "", err
+		}
+		denominator, err := p.argument()
+		if err != nil {
+			return "", err
+		}
+		return fraction(numerator, denominator), nil
+
+	case "sqrt":
+		var index string
+		p.skipSpaces()
+		if r, ok := p.peek(); ok && r == '[' {
+			end := p.pos
+			for end < len(p.input) && p.input[end] != ']' {
+				end++
+			}
+			if end == len(p.input) {
+				return "", fmt.Errorf("%w: missing ]", errMath)
+			}
+			index = strings.TrimSpace(string(p.input[p.pos+1 : end]))
+			p.pos = end + 1
+		}
+		radicand, err := p.argument()
+		if err != nil {
+			return "", err
+		}
+		return root(index, radicand), nil
+
+	case "text", "textrm", "mathrm", "operatorname", "mbox", "textbf", "mathbf", "mathit", "textit":
+		return p.rawArgument()
+
+	case "mathbb":
+		arg, err := p.rawArgument()
+		if err != nil {
+			return "", err
+		}
+		var b strings.Builder
+		for _, r := range arg {
+			letter, ok := mathBlackboard[r]
+			if !ok {
+				return "", fmt.Errorf("%w: \\mathbb{%c}", errMath, r)
+			}
+			b.WriteString(letter)
+		}
+		return b.String(), nil
+
+	case "left", "right", "big", "Big", "bigg", "Bigg":
+		// the delimiters are written as they are, the sizes make no sense in the terminal.
+		// the null delimiter \left. is dropped.
+		p.skipSpaces()
+		if r, ok := p.peek(); ok && r == '.' {
+			p.pos++
+		}
+		return "", nil
+	}
+
+	return "", fmt.Errorf("%w: \\%s", errMath, name)
+}
+
+// script writes the text with the superscript or subscript characters, or with the mark
+// followed by the text in parentheses when some character has none.
+func script(text string, characters map[rune]rune, mark string) string {
+	text = strings.ReplaceAll(text, " ", "")
+
+	var b strings.Builder
+	for _, r := range text {
+		c, ok := characters[r]
+		if !ok {
+			if len([]rune(text)) == 1 {
+				return mark + text
+			}
+			return mark + "(" + text + ")"
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// fraction writes the fraction with a single character when there is one, with superscript
+// and subscript digits when the terms are numbers, or with a slash otherwise.
+func fraction(numerator, denominator string) string {
+	numerator, denominator = strings.TrimSpace(numerator), strings.TrimSpace(denominator)
+	if vulgar, ok := vulgarFractions[numerator+"/"+denominator]; ok {
+		return vulgar
+	}
+
+	if isNumber(numerator) && isNumber(denominator) {
+		return script(numerator, superscripts, "") + "⁄" + script(denominator, subscripts, "")
+	}
+
+	return group(numerator) + "/" + group(denominator)
+}
+
+// root writes the radical sign, with the index of the cube and fourth roots, before the radicand.
+func root(index, radicand string) string {
+	sign := "√"
+	switch index {
+	case "":
+	case "3":
+		sign = "∛"
+	case "4":
+		sign = "∜"
+	default:
+		sign = script(index, superscripts, "") + "√"
+	}
+	return sign + group(radicand)
+}
+
+// group wraps the expressions with more than one term in parentheses.
+func group(expression string) string {
+	if len([]rune(expression)) <= 1 || isNumber(expression) || isWord(expression) {
+		return expression
+	}
+	return "(" + expression + ")"
+}
+
+func isNumber(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' }) < 0
+}
+
+func isWord(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) }) < 0
+}
@@ -0,0 +1,54 @@
+package tui_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eliostvs/lembrol/internal/tui"
+)
+
+func TestRenderMath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "no math", text: "plain text", want: "plain text"},
+		{name: "greek letters", text: "$\\alpha + \\beta = \\Omega$", want: "α + β = Ω"},
+		{name: "superscripts", text: "$x^2 + y^{n+1}$", want: "x² + yⁿ⁺¹"},
+		{name: "subscripts", text: "$a_i + a_{10}$", want: "aᵢ + a₁₀"},
+		{name: "unconvertible script", text: "$x^{q} + y_z$", want: "x^q + y_z"},
+		{name: "unconvertible script group", text: "$e^{i\\pi}$", want: "e^(iπ)"},
+		{name: "vulgar fraction", text: "$\\frac{1}{2}$", want: "½"},
+		{name: "numeric fraction", text: "$\\frac{12}{17}$", want: "¹²⁄₁₇"},
+		{name: "fraction", text: "$\\frac{a+b}{c}$", want: "(a+b)/c"},
+		{name: "square root", text: "$\\sqrt{x^2+1}$", want: "√(x²+1)"},
+		{name: "cube root", text: "$\\sqrt[3]{8} = 2$", want: "∛8 = 2"},
+		{name: "nth root", text: "$\\sqrt[n]{x}$", want: "ⁿ√x"},
+		{name: "operators", text: "$a \\times b \\leq c \\neq \\infty$", want: "a × b ≤ c ≠ ∞"},
+		{name: "quadratic formula", text: "$x = \\frac{-b \\pm \\sqrt{b^2 - 4ac}}{2a}$", want: "x = (-b ± √(b² - 4ac))/(2a)"},
+		{name: "sets", text: "$x \\in \\mathbb{R}$", want: "x ∈ ℝ"},
+		{name: "functions", text: "$\\sin^2 x + \\cos^2 x = 1$", want: "sin² x + cos² x = 1"},
+		{name: "text", text: "$v = \\text{distance}/t$", want: "v = distance/t"},
+		{name: "delimiters", text: "$\\left( \\frac{x}{2} \\right)$", want: "( x/2 )"},
+		{name: "accent", text: "$\\vec{v}$", want: "v⃗"},
+		{name: "inside text", text: "The area is $\\pi r^2$.", want: "The area is π r²."},
+		{name: "display math", text: "$$\\sum_{i=1}^n i$$", want: "∑ᵢ₌₁ⁿ i"},
+		{name: "unknown command", text: "$\\unknown{x}$", want: "$\\unknown{x}$"},
+		{name: "unbalanced braces", text: "$x^{2$", want: "$x^{2$"},
+		{name: "prices", text: "costs $5 and $10", want: "costs $5 and $10"},
+		{name: "escaped dollar", text: "\\$x$", want: "\\$x$"},
+		{name: "code span", text: "`$x^2$` is $x^2$", want: "`$x^2$` is x²"},
+		{name: "code block", text: "```\n$x^2$\n```", want: "```\n$x^2$\n```"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tui.RenderMath(tt.text))
+			},
+		)
+	}
+}
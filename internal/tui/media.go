@@ -0,0 +1,215 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	_ "image/gif"  // decodes the GIF images of the cards
+	_ "image/jpeg" // decodes the JPEG images of the cards
+	"image/png"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+// ImageProtocol is how the images of the cards are drawn in the terminal.
+type ImageProtocol string
+
+const (
+	// ImagesAuto detects the protocol supported by the terminal.
+	ImagesAuto ImageProtocol = "auto"
+	// ImagesNone shows the path of the images instead of drawing them.
+	ImagesNone ImageProtocol = "none"
+	// ImagesKitty draws the images with the kitty graphics protocol.
+	ImagesKitty ImageProtocol = "kitty"
+	// ImagesSixel draws the images with sixels.
+	ImagesSixel ImageProtocol = "sixel"
+)
+
+const (
+	// cellWidth and cellHeight are the usual size in pixels of a terminal cell, used to scale the images.
+	cellWidth  = 8
+	cellHeight = 16
+	// kittyChunk is the maximum size of the base64 data sent in each kitty graphics sequence.
+	kittyChunk = 4096
+)
+
+// DetectImageProtocol guesses the image protocol supported by the terminal from its environment variables.
+func DetectImageProtocol(getenv func(string) string) ImageProtocol {
+	term, program := getenv("TERM"), getenv("TERM_PROGRAM")
+	switch {
+	case getenv("KITTY_WINDOW_ID") != "" || term == "xterm-kitty" || term == "xterm-ghostty" || program == "ghostty":
+		return ImagesKitty
+	case strings.HasPrefix(term, "foot") || strings.HasPrefix(term, "mlterm") || strings.Contains(term, "sixel") || program == "WezTerm":
+		return ImagesSixel
+	}
+	return ImagesNone
+}
+
+// renderContent renders the Markdown text of a card with its images resolved against the media directory of the deck,
+// followed by the images drawn by renderImages.
+func renderContent(deck flashcard.Deck, text string, images []string, width int) (string, error) {
+	markdown, err := RenderMarkdown(deck.ResolveMedia(text), width)
+	if err != nil {
+		return "", err
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, append([]string{markdown}, images...)...), nil
+}
+
+// renderImages draws the images of the card text when the terminal supports them, otherwise their path in the text
+// is all shown. Drawing decodes and encodes every image, so the pages draw them once per card and width.
+func renderImages(deck flashcard.Deck, text string, protocol ImageProtocol, width int) []string {
+	if protocol == ImagesNone || protocol == "" {
+		return nil
+	}
+
+	var images []string
+	for _, target := range flashcard.Images(deck.ResolveMedia(text)) {
+		// the remote images and the ones that cannot be read keep the path as a fallback.
+		if strings.Contains(target, "://") {
+			continue
+		}
+		if image, err := RenderImage(target, protocol, width); err == nil {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// RenderImage draws the image file with the protocol, scaled to fit in the width, followed by
+// the empty lines it covers.
+func RenderImage(file string, protocol ImageProtocol, width int) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return "", fmt.Errorf("decode image '%s': %w", file, err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return "", fmt.Errorf("decode image '%s': empty image", file)
+	}
+	cols := max(1, min(width, (bounds.Dx()+cellWidth-1)/cellWidth))
+	rows := max(1, (cols*cellWidth*bounds.Dy()/bounds.Dx()+cellHeight-1)/cellHeight)
+
+	var sequence string
+	switch protocol {
+	case ImagesKitty:
+		sequence, err = kittyImage(img, cols, rows)
+	case ImagesSixel:
+		sequence = sixelImage(img, cols*cellWidth, rows*cellHeight)
+	default:
+		err = fmt.Errorf("unknown image protocol %q", protocol)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return sequence + strings.Repeat("\n", rows-1), nil
+}
+
+// kittyImage transmits the image as PNG and places it over the cells, leaving the cursor where it was.
+func kittyImage(img image.Image, cols, rows int) (string, error) {
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		return "", err
+	}
+	payload := base64.StdEncoding.EncodeToString(data.Bytes())
+
+	var b strings.Builder
+	for i := 0; i < len(payload); i += kittyChunk {
+		end := min(i+kittyChunk, len(payload))
+		more := "m=0"
+		if end < len(payload) {
+			more = "m=1"
+		}
+
+		opts := []string{more}
+		if i == 0 {
+			opts = []string{"a=T", "f=100", "q=2", "C=1", "c=" + strconv.Itoa(cols), "r=" + strconv.Itoa(rows), more}
+		}
+		b.WriteString(ansi.KittyGraphics([]byte(payload[i:end]), opts...))
+	}
+	return b.String(), nil
+}
+
+// sixelImage scales the image to the size in pixels and encodes it as sixels with the web safe palette.
+func sixelImage(img image.Image, width, height int) string {
+	bounds := img.Bounds()
+	paletted := image.NewPaletted(image.Rect(0, 0, width, height), palette.WebSafe)
+	scaled := image.NewRGBA(paletted.Rect)
+	for y := range height {
+		for x := range width {
+			scaled.Set(x, y, img.At(bounds.Min.X+x*bounds.Dx()/width, bounds.Min.Y+y*bounds.Dy()/height))
+		}
+	}
+	draw.FloydSteinberg.Draw(paletted, paletted.Rect, scaled, image.Point{})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\"1;1;%d;%d", width, height)
+	for i, c := range paletted.Palette {
+		r, g, bl, _ := c.RGBA()
+		fmt.Fprintf(&b, "#%d;2;%d;%d;%d", i, r*100/0xffff, g*100/0xffff, bl*100/0xffff)
+	}
+
+	for band := 0; band < height; band += 6 {
+		used := make(map[uint8]bool)
+		for y := band; y < min(band+6, height); y++ {
+			for x := range width {
+				used[paletted.ColorIndexAt(x, y)] = true
+			}
+		}
+
+		for index := range len(paletted.Palette) {
+			if !used[uint8(index)] {
+				continue
+			}
+
+			fmt.Fprintf(&b, "#%d", index)
+			sixels := make([]byte, width)
+			for x := range width {
+				var bits byte
+				for y := band; y < min(band+6, height); y++ {
+					if paletted.ColorIndexAt(x, y) == uint8(index) {
+						bits |= 1 << (y - band)
+					}
+				}
+				sixels[x] = '?' + bits
+			}
+			writeSixels(&b, sixels)
+			b.WriteByte('$')
+		}
+		b.WriteByte('-')
+	}
+
+	return ansi.SixelGraphics(0, 1, 0, []byte(b.String()))
+}
+
+// writeSixels writes the sixels of a color in a band, repeating the same ones with the !count syntax.
+func writeSixels(b *strings.Builder, sixels []byte) {
+	for i := 0; i < len(sixels); {
+		j := i
+		for j < len(sixels) && sixels[j] == sixels[i] {
+			j++
+		}
+		if count := j - i; count > 3 {
+			fmt.Fprintf(b, "!%d%c", count, sixels[i])
+		} else {
+			b.WriteString(strings.Repeat(string(sixels[i]), count))
+		}
+		i = j
+	}
+}
@@ -0,0 +1,71 @@
+package tui_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eliostvs/lembrol/internal/tui"
+)
+
+func TestDetectImageProtocol(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want tui.ImageProtocol
+	}{
+		{name: "kitty", env: map[string]string{"TERM": "xterm-kitty"}, want: tui.ImagesKitty},
+		{name: "kitty window", env: map[string]string{"TERM": "xterm-256color", "KITTY_WINDOW_ID": "1"}, want: tui.ImagesKitty},
+		{name: "foot", env: map[string]string{"TERM": "foot"}, want: tui.ImagesSixel},
+		{name: "wezterm", env: map[string]string{"TERM_PROGRAM": "WezTerm"}, want: tui.ImagesSixel},
+		{name: "unknown", env: map[string]string{"TERM": "xterm-256color"}, want: tui.ImagesNone},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				getenv := func(key string) string { return tt.env[key] }
+
+				assert.Equal(t, tt.want, tui.DetectImageProtocol(getenv))
+			},
+		)
+	}
+}
+
+func TestRenderImage(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(mediaDeck, "diagrams.media", "handshake.png")
+
+	t.Run(
+		"draws with kitty", func(t *testing.T) {
+			image, err := tui.RenderImage(file, tui.ImagesKitty, 80)
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(image, "\x1b_Ga=T,f=100,q=2,C=1,c=1,r=1,m=0;"))
+			assert.True(t, strings.HasSuffix(image, "\x1b\\"))
+		},
+	)
+
+	t.Run(
+		"draws with sixels", func(t *testing.T) {
+			image, err := tui.RenderImage(file, tui.ImagesSixel, 80)
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(image, "\x1bP0;1q\"1;1;8;16"))
+			assert.True(t, strings.HasSuffix(image, "\x1b\\"))
+		},
+	)
+
+	t.Run(
+		"returns error when the file is not an image", func(t *testing.T) {
+			_, err := tui.RenderImage(filepath.Join(mediaDeck, "diagrams.json"), tui.ImagesKitty, 80)
+
+			assert.ErrorContains(t, err, "decode image")
+		},
+	)
+}
@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/eliostvs/lembrol/internal/clock"
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+// resumable returns the session saved in the first deck when it was started with the same decks
+// and filter, and still has cards left.
+func resumable(
+	decks []flashcard.Deck, filter *flashcard.Filter, clock clock.Clock, opts ...flashcard.ReviewOption,
+) (flashcard.Review, bool) {
+	if len(decks) == 0 || decks[0].Session == nil {
+		return flashcard.Review{}, false
+	}
+
+	saved := decks[0].Session.Filter
+	if (saved == nil) != (filter == nil) || saved != nil && *saved != *filter {
+		return flashcard.Review{}, false
+	}
+
+	review, err := flashcard.ResumeReview(decks, *decks[0].Session, clock, opts...)
+	if err != nil || review.Left() == 0 {
+		return flashcard.Review{}, false
+	}
+
+	return review, true
+}
+
+type resumeKeyMap struct {
+	resume  key.Binding
+	restart key.Binding
+	quit    key.Binding
+}
+
+func (k resumeKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.resume, k.restart, k.quit}
+}
+
+func (k resumeKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+func newResumePage(shared Shared, review flashcard.Review, start setReviewPageMsg) resumePage {
+	return resumePage{
+		Shared: shared,
+		review: review,
+		start:  start,
+		keyMap: resumeKeyMap{
+			resume: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "resume"),
+			),
+			restart: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "start over"),
+			),
+			quit: key.NewBinding(
+				key.WithKeys("q", "esc"),
+				key.WithHelp("q", "quit"),
+			),
+		},
+	}
+}
+
+// resumePage offers to continue the review interrupted before.
+type resumePage struct {
+	Shared
+	review flashcard.Review
+	start  setReviewPageMsg
+	keyMap resumeKeyMap
+}
+
+func (m resumePage) Init() tea.Cmd {
+	m.Log("resume: init")
+
+	return nil
+}
+
+func (m resumePage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("resume update: msg=%T", msg)
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.resume):
+			return m, resumeReview(m.review)
+
+		case key.Matches(msg, m.keyMap.restart):
+			start := m.start
+			start.restart = true
+			return m, func() tea.Msg { return start }
+
+		case key.Matches(msg, m.keyMap.quit):
+			return m, leaveReview(m.review.Decks())
+		}
+	}
+
+	return m, nil
+}
+
+func (m resumePage) View() string {
+	m.Log("resume view: width=%d height=%d", m.width, m.height)
+
+	header := m.styles.Title.
+		Margin(1, 2).
+		Render("Review")
+
+	names := make([]string, 0, len(m.review.Decks()))
+	for _, deck := range m.review.Decks() {
+		names = append(names, deck.Name)
+	}
+
+	subTitle := m.styles.SubTitle.
+		Width(m.width).
+		Margin(0, 2).
+		Render(strings.Join(names, ", "))
+
+	footer := lipgloss.
+		NewStyle().
+		Width(m.width).
+		Margin(1, 2).
+		Render(renderHelp(m.keyMap, m.width, false))
+
+	left := m.review.Left()
+	content := m.styles.Text.
+		Width(m.width).
+		Margin(1, 2, 0).
+		Height(m.height - lipgloss.Height(header) - lipgloss.Height(subTitle) - lipgloss.Height(footer)).
+		Render(
+			fmt.Sprintf(
+				"Resume the review started at %s?\n\n%d card%s reviewed, %d card%s left.",
+				m.review.StartedAt.Format(time.Kitchen),
+				m.review.Completed, pluralize(m.review.Completed, "s"),
+				left, pluralize(left, "s"),
+			),
+		)
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, content, footer)
+}
@@ -2,11 +2,16 @@ package tui
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/eliostvs/lembrol/internal/flashcard"
@@ -14,45 +19,183 @@ import (
 
 // Messages
 
-func showAnswer(review flashcard.Review) tea.Cmd {
+func showQuestion(review flashcard.Review) tea.Cmd {
+	return func() tea.Msg {
+		return showQuestionMsg{review}
+	}
+}
+
+func showAnswer(review flashcard.Review, response response) tea.Cmd {
 	return func() tea.Msg {
 		return showAnswerMsg{
-			review,
+			Review:   review,
+			response: response,
 		}
 	}
 }
 
-func skipCard(review flashcard.Review) tea.Cmd {
+func skipCard(review flashcard.Review, repository Repository) tea.Cmd {
 	return func() tea.Msg {
 		review, err := review.Skip()
 		if err != nil {
 			return fail(err)
 		}
-		return showQuestionMsg{review}
+		if review.GoalReached() {
+			return endReview(review, repository)
+		}
+		return nextQuestion(review)
 	}
 }
 
-func scoreCard(rawScore string, review flashcard.Review, repository Repository) tea.Cmd {
+func buryCard(review flashcard.Review, repository Repository) tea.Cmd {
 	return func() tea.Msg {
-		score, err := flashcard.NewReviewScore(rawScore)
+		review, err := review.Bury()
 		if err != nil {
 			return fail(err)
 		}
 
-		review, err := review.Rate(score)
+		return saveReview(review, repository)
+	}
+}
+
+func suspendCard(review flashcard.Review, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		review, err := review.Suspend()
 		if err != nil {
 			return fail(err)
 		}
 
-		if err = repository.Save(review.Deck); err != nil {
+		return saveReview(review, repository)
+	}
+}
+
+// flagCard flags the current card and saves it, the card staying in the review.
+func flagCard(review flashcard.Review, flag flashcard.Flag, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		review, err := review.SetFlag(flag)
+		if err != nil {
 			return fail(err)
 		}
 
-		if review.Left() == 0 {
-			return showReviewSummaryMsg{review}
+		if err := repository.Save(review.Deck); err != nil {
+			return fail(err)
 		}
 
-		return showQuestionMsg{review}
+		return cardFlaggedMsg{review}
+	}
+}
+
+// flagNextCard flags the current card with the flag after its own.
+func flagNextCard(review flashcard.Review, repository Repository) tea.Cmd {
+	card, err := review.Card()
+	if err != nil {
+		return func() tea.Msg { return fail(err) }
+	}
+	return flagCard(review, card.Flag.Next(), repository)
+}
+
+// flagNotice shows the flag of the card next to the progress of the review.
+func flagNotice(card flashcard.Card) string {
+	if !card.IsFlagged() {
+		return ""
+	}
+	return " • " + flagView(card.Flag)
+}
+
+// leaveReview goes back to the cards of the deck studied, or to the decks when many were studied together.
+func leaveReview(decks []flashcard.Deck) tea.Cmd {
+	if len(decks) != 1 {
+		return showDecks(0)
+	}
+	return showCards(0, decks[0])
+}
+
+// quitReview saves the session in the first deck to be resumed later and leaves the review.
+func quitReview(review flashcard.Review, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		decks := slices.Clone(review.Decks())
+		session := review.Session()
+		decks[0].Session = &session
+
+		if err := repository.Save(decks[0]); err != nil {
+			return fail(err)
+		}
+		if err := discardSessions(decks, decks[0].ID, repository); err != nil {
+			return fail(err)
+		}
+
+		return leaveReview(decks)()
+	}
+}
+
+func saveReview(review flashcard.Review, repository Repository) tea.Msg {
+	if err := repository.Save(review.Deck); err != nil {
+		return fail(err)
+	}
+
+	if review.Left() == 0 || review.GoalReached() {
+		return endReview(review, repository)
+	}
+
+	return nextQuestion(review)
+}
+
+// endReview shows the summary of the review once there are no cards left or the goal is reached.
+func endReview(review flashcard.Review, repository Repository) tea.Msg {
+	// The session is over, so there is nothing left to resume.
+	if err := discardSessions(review.Decks(), "", repository); err != nil {
+		return fail(err)
+	}
+
+	return showReviewSummaryMsg{review}
+}
+
+// discardSessions clears the sessions that studied any of the decks, except the one saved in the deck kept,
+// as the last review supersedes them. A review of many decks saves its session in the first deck only,
+// so the sessions are searched in all the decks of the repository.
+func discardSessions(decks []flashcard.Deck, keep string, repository Repository) error {
+	studied := make(map[string]bool, len(decks))
+	for _, deck := range decks {
+		studied[deck.Name] = true
+	}
+
+	for _, deck := range repository.List() {
+		if deck.ID == keep || deck.Session == nil {
+			continue
+		}
+		if !slices.ContainsFunc(deck.Session.Decks, func(name string) bool { return studied[name] }) {
+			continue
+		}
+
+		deck.Session = nil
+		if err := repository.Save(deck); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextQuestion shows the next card or waits until its learning step is due.
+func nextQuestion(review flashcard.Review) tea.Msg {
+	if review.Wait() > 0 {
+		return showWaitMsg{review}
+	}
+	return showQuestionMsg{review}
+}
+
+func scoreCard(rawScore string, review flashcard.Review, repository Repository) tea.Cmd {
+	return func() tea.Msg {
+		score, err := flashcard.NewReviewScore(rawScore)
+		if err != nil {
+			return fail(err)
+		}
+
+		review, err := review.Rate(score)
+		if err != nil {
+			return fail(err)
+		}
+
+		return saveReview(review, repository)
 	}
 }
 
@@ -63,25 +206,40 @@ type (
 
 	showAnswerMsg struct {
 		flashcard.Review
+		response response
 	}
 
 	showReviewSummaryMsg struct {
 		flashcard.Review
 	}
 
-	setupQuestionMsg struct{}
+	showWaitMsg struct {
+		flashcard.Review
+	}
+
+	cardFlaggedMsg struct {
+		flashcard.Review
+	}
 
-	setupAnswerPageMsg struct{}
+	setupQuestionMsg struct{}
 )
 
+// response is what was answered in the question of the cards that are not self graded.
+type response struct {
+	// typed is the answer typed for the cards that ask to type it.
+	typed string
+	// choice is the option chosen for the multiple choice cards.
+	choice string
+}
+
 // Question Page
 
 type questionKeyMap struct {
-	skip, answer, quit key.Binding
+	skip, answer, choose, hint, bury, suspend, flag, quit key.Binding
 }
 
 func (k questionKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.skip, k.answer, k.quit}
+	return []key.Binding{k.skip, k.choose, k.answer, k.hint, k.bury, k.suspend, k.flag, k.quit}
 }
 
 func (k questionKeyMap) FullHelp() [][]key.Binding {
@@ -90,7 +248,14 @@ func (k questionKeyMap) FullHelp() [][]key.Binding {
 			k.skip,
 		},
 		{
+			k.choose,
 			k.answer,
+			k.hint,
+		},
+		{
+			k.bury,
+			k.suspend,
+			k.flag,
 		},
 		{
 			k.quit,
@@ -106,10 +271,29 @@ func newQuestionPage(shared reviewShared) questionPage {
 				key.WithKeys("enter"),
 				key.WithHelp("enter", "answer"),
 			),
+			choose: key.NewBinding(
+				key.WithDisabled(),
+			),
+			hint: key.NewBinding(
+				key.WithKeys("h"),
+				key.WithHelp("h", "hint"),
+			),
 			skip: key.NewBinding(
 				key.WithKeys("s"),
 				key.WithHelp("s", "skip"),
 			),
+			bury: key.NewBinding(
+				key.WithKeys("b"),
+				key.WithHelp("b", "bury"),
+			),
+			suspend: key.NewBinding(
+				key.WithKeys("u"),
+				key.WithHelp("u", "suspend"),
+			),
+			flag: key.NewBinding(
+				key.WithKeys("f"),
+				key.WithHelp("f", "flag"),
+			),
 			quit: key.NewBinding(
 				key.WithKeys("q", "esc"),
 				key.WithHelp("q", "quit"),
@@ -121,6 +305,13 @@ func newQuestionPage(shared reviewShared) questionPage {
 type questionPage struct {
 	reviewShared
 	keyMap questionKeyMap
+	// input receives the answer of the cards that ask to type it.
+	input  textinput.Model
+	typing bool
+	// choices are the options of the multiple choice cards.
+	choices []string
+	// cardImages are the images of the question, drawn once per card and width.
+	cardImages []string
 }
 
 func (m questionPage) Init() tea.Cmd {
@@ -136,29 +327,132 @@ func (m questionPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case setupQuestionMsg:
+		m.review = m.review.Show()
 		m.keyMap.skip.SetEnabled(m.review.Left() > 1)
+		m.keyMap.hint.SetEnabled(m.review.HasHints())
+		m = m.drawImages()
+
+		card, err := m.review.Card()
+		switch {
+		case err != nil:
+		case card.MultipleChoice:
+			return m.showChoices(card), nil
+		case card.TypeAnswer:
+			return m.typeAnswer()
+		}
 		return m, nil
 
 	case tea.WindowSizeMsg:
 		m.width, m.height = msg.Width, msg.Height
+		return m.drawImages(), nil
+
+	case cardFlaggedMsg:
+		m.review = msg.Review
 		return m, nil
 
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keyMap.skip) && m.review.Left() > 1:
-			return m, skipCard(m.review)
+			return m, skipCard(m.review, m.repository)
 
 		case key.Matches(msg, m.keyMap.answer):
-			return m, showAnswer(m.review)
+			return m, showAnswer(m.review, response{typed: m.input.Value()})
+
+		case key.Matches(msg, m.keyMap.choose):
+			index := int(msg.Runes[0] - '1')
+			return m, showAnswer(m.review, response{choice: m.choices[index]})
+
+		case key.Matches(msg, m.keyMap.hint):
+			review, err := m.review.RevealHint()
+			if err != nil {
+				return m, func() tea.Msg { return fail(err) }
+			}
+			m.review = review
+			m.keyMap.hint.SetEnabled(m.review.HasHints())
+			return m, nil
+
+		case key.Matches(msg, m.keyMap.flag):
+			return m, flagNextCard(m.review, m.repository)
+
+		case key.Matches(msg, m.keyMap.bury):
+			return m, tea.Batch(
+				showLoading("Review", "Burying card..."),
+				buryCard(m.review, m.repository),
+			)
+
+		case key.Matches(msg, m.keyMap.suspend):
+			return m, tea.Batch(
+				showLoading("Review", "Suspending card..."),
+				suspendCard(m.review, m.repository),
+			)
 
 		case key.Matches(msg, m.keyMap.quit):
-			return m, showCards(0, m.review.Deck)
+			return m, quitReview(m.review, m.repository)
 		}
 	}
 
+	if m.typing {
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+
 	return m, nil
 }
 
+// drawImages draws the images of the question for the width of the page.
+func (m questionPage) drawImages() questionPage {
+	card, err := m.review.Card()
+	if err != nil {
+		return m
+	}
+	deck, err := m.review.CurrentDeck()
+	if err != nil {
+		return m
+	}
+
+	m.cardImages = renderImages(deck, card.Front(), m.images, m.width-m.styles.Markdown.GetHorizontalFrameSize())
+	return m
+}
+
+// showChoices numbers the options of the card to be chosen by their numbers.
+func (m questionPage) showChoices(card flashcard.Card) questionPage {
+	deck, err := m.review.CurrentDeck()
+	if err != nil {
+		return m
+	}
+
+	m.choices = deck.Choices(card, m.clock.Now().UnixNano())
+	keys := make([]string, 0, len(m.choices))
+	for i := range m.choices[:min(len(m.choices), 9)] {
+		keys = append(keys, strconv.Itoa(i+1))
+	}
+	m.keyMap.choose.SetKeys(keys...)
+	m.keyMap.choose.SetHelp("1-"+keys[len(keys)-1], "choose")
+	m.keyMap.choose.SetEnabled(true)
+
+	return m
+}
+
+// typeAnswer shows the input for the answer, leaving the letter keys to it.
+func (m questionPage) typeAnswer() (questionPage, tea.Cmd) {
+	m.typing = true
+	m.input = textinput.New()
+	m.input.Placeholder = "Type the answer"
+	m.input.Width = max(0, m.width-m.styles.Markdown.GetHorizontalFrameSize()-len(m.input.Prompt)-1)
+
+	m.keyMap.skip.SetEnabled(false)
+	m.keyMap.bury.SetEnabled(false)
+	m.keyMap.suspend.SetEnabled(false)
+	m.keyMap.flag.SetEnabled(false)
+	m.keyMap.quit.SetKeys("esc")
+	m.keyMap.quit.SetHelp("esc", "quit")
+	m.keyMap.hint.SetKeys("tab")
+	m.keyMap.hint.SetHelp("tab", "hint")
+
+	return m, m.input.Focus()
+}
+
 func (m questionPage) View() string {
 	m.Log("question view: width=%d height=%d", m.width, m.height)
 
@@ -166,23 +460,40 @@ func (m questionPage) View() string {
 		Margin(1, 2).
 		Render("Question")
 
+	card, err := m.review.Card()
+	if err != nil {
+		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+	}
+	deck, err := m.review.CurrentDeck()
+	if err != nil {
+		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+	}
+
 	subTitle := m.styles.SubTitle.
 		Width(m.width).
 		Margin(0, 2).
-		Render(m.review.Deck.Name)
+		Render(deck.Name)
 
 	position := m.styles.Text.
 		Width(m.width).
 		Margin(1, 2, 0).
-		Render(fmt.Sprintf("%d of %d", m.review.Current(), m.review.Total()))
+		Render(progress(m.review) + flagNotice(card))
 
-	card, err := m.review.Card()
+	markdown, err := renderContent(deck, card.Front(), m.cardImages, m.width-m.styles.Markdown.GetHorizontalFrameSize())
 	if err != nil {
 		return errorView(m.Shared, newErrorKeyMap(), err.Error())
 	}
-	markdown, err := RenderMarkdown(card.Question, m.width-m.styles.Markdown.GetHorizontalFrameSize())
-	if err != nil {
-		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+
+	for i, hint := range m.review.Hints() {
+		markdown = lipgloss.JoinVertical(lipgloss.Top, markdown, m.styles.Text.Render(fmt.Sprintf("Hint %d: %s", i+1, RenderMath(hint))))
+	}
+
+	if m.typing {
+		markdown = lipgloss.JoinVertical(lipgloss.Top, markdown, m.input.View())
+	}
+
+	for i, choice := range m.choices {
+		markdown = lipgloss.JoinVertical(lipgloss.Top, markdown, fmt.Sprintf("%d. %s", i+1, choice))
 	}
 
 	footer := lipgloss.
@@ -202,11 +513,11 @@ func (m questionPage) View() string {
 // Answer Page
 
 type answerKeyMap struct {
-	quit, score, again, workaround, hard, good, easy, showFullHelp, closeFullHelp key.Binding
+	quit, score, accept, again, workaround, hard, good, easy, flag, showFullHelp, closeFullHelp key.Binding
 }
 
 func (k answerKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.again, k.hard, k.good, k.easy, k.quit, k.showFullHelp}
+	return []key.Binding{k.accept, k.again, k.hard, k.good, k.easy, k.quit, k.showFullHelp}
 }
 
 func (k answerKeyMap) FullHelp() [][]key.Binding {
@@ -222,20 +533,26 @@ func (k answerKeyMap) FullHelp() [][]key.Binding {
 			k.easy,
 		},
 		{
+			k.accept,
+			k.flag,
 			k.quit,
 			k.closeFullHelp,
 		},
 	}
 }
 
-func newAnswerPage(shared reviewShared) answerPage {
-	return answerPage{
+func newAnswerPage(shared reviewShared, response response) answerPage {
+	m := answerPage{
 		reviewShared: shared,
 		keyMap: answerKeyMap{
 			score: key.NewBinding(
 				key.WithKeys("1", "2", "3", "4"),
 				key.WithHelp("1", "score"),
 			),
+			accept: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithDisabled(),
+			),
 			again: key.NewBinding(
 				key.WithKeys("1"),
 				key.WithHelp("1", "again"),
@@ -257,6 +574,10 @@ func newAnswerPage(shared reviewShared) answerPage {
 				key.WithKeys("4"),
 				key.WithHelp("4", "easy"),
 			),
+			flag: key.NewBinding(
+				key.WithKeys("f"),
+				key.WithHelp("f", "flag"),
+			),
 			showFullHelp: key.NewBinding(
 				key.WithKeys("?"),
 				key.WithHelp("?", "more"),
@@ -271,32 +592,80 @@ func newAnswerPage(shared reviewShared) answerPage {
 			),
 		},
 	}
+
+	card, err := shared.review.Card()
+	if err != nil {
+		return m
+	}
+	deck, err := shared.review.CurrentDeck()
+	if err != nil {
+		return m
+	}
+	m = m.drawImages()
+
+	// the cards answered with hints are suggested as hard at best.
+	hints := len(shared.review.Hints())
+	switch {
+	case card.MultipleChoice && response.choice != "":
+		m.choice = response.choice
+		m.suggested = flashcard.HintedScore(card.GradeChoice(response.choice), hints)
+
+	case card.TypeAnswer:
+		comparison := flashcard.CompareAnswer(response.typed, card.Expected(), deck.Settings)
+		m.comparison = &comparison
+		m.suggested = flashcard.HintedScore(comparison.Score, hints)
+
+	case hints > 0:
+		m.suggested = flashcard.HintedScore(flashcard.ReviewScoreEasy, hints)
+
+	default:
+		return m
+	}
+
+	m.keyMap.accept.SetEnabled(true)
+	m.keyMap.accept.SetHelp("enter", scoreNames[m.suggested])
+
+	return m
+}
+
+// scoreNames are the names of the scores shown to the user.
+var scoreNames = map[flashcard.ReviewScore]string{
+	flashcard.ReviewScoreAgain: "again",
+	flashcard.ReviewScoreHard:  "hard",
+	flashcard.ReviewScoreGood:  "good",
+	flashcard.ReviewScoreEasy:  "easy",
 }
 
 type answerPage struct {
 	reviewShared
 	keyMap   answerKeyMap
 	fullHelp bool
+	// comparison of the typed answer with the expected one, for the cards that ask to type it.
+	comparison *flashcard.Comparison
+	// choice is the option chosen for the multiple choice cards.
+	choice string
+	// suggested is the score graded from the response, accepted with enter.
+	suggested flashcard.ReviewScore
+	// cardImages are the images of the answer, drawn once per card and width.
+	cardImages []string
 }
 
 func (m answerPage) Init() tea.Cmd {
 	m.Log("answer: init")
 
-	return func() tea.Msg {
-		return setupAnswerPageMsg{}
-	}
+	return nil
 }
 
 func (m answerPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.Log("answer update: msg=%T total=%d", msg, m.review.Total())
 
 	switch msg := msg.(type) {
-	case setupAnswerPageMsg:
-		m.keyMap.again.SetEnabled(m.review.Left() > 1)
-		return m, nil
-
 	case tea.WindowSizeMsg:
 		m.width, m.height = msg.Width, msg.Height
+		return m.drawImages(), nil
+
+	case cardFlaggedMsg:
+		m.review = msg.Review
 		return m, nil
 
 	case tea.KeyMsg:
@@ -307,6 +676,15 @@ func (m answerPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				scoreCard(msg.String(), m.review, m.repository),
 			)
 
+		case key.Matches(msg, m.keyMap.accept):
+			return m, tea.Batch(
+				showLoading("Review", "Scoring card..."),
+				scoreCard(m.suggested.String(), m.review, m.repository),
+			)
+
+		case key.Matches(msg, m.keyMap.flag):
+			return m, flagNextCard(m.review, m.repository)
+
 		case key.Matches(msg, m.keyMap.showFullHelp):
 			fallthrough
 
@@ -315,13 +693,28 @@ func (m answerPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case key.Matches(msg, m.keyMap.quit):
-			return m, showCards(0, m.review.Deck)
+			return m, quitReview(m.review, m.repository)
 		}
 	}
 
 	return m, nil
 }
 
+// drawImages draws the images of the answer for the width of the page.
+func (m answerPage) drawImages() answerPage {
+	card, err := m.review.Card()
+	if err != nil {
+		return m
+	}
+	deck, err := m.review.CurrentDeck()
+	if err != nil {
+		return m
+	}
+
+	m.cardImages = renderImages(deck, card.Back(), m.images, m.width-m.styles.Markdown.GetHorizontalFrameSize())
+	return m
+}
+
 func (m answerPage) View() string {
 	m.Log("answer view: width=%d height=%d", m.width, m.height)
 
@@ -329,23 +722,49 @@ func (m answerPage) View() string {
 		Margin(1, 2).
 		Render("Answer")
 
+	card, err := m.review.Card()
+	if err != nil {
+		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+	}
+	deck, err := m.review.CurrentDeck()
+	if err != nil {
+		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+	}
+
 	subTitle := m.styles.SubTitle.
 		Width(m.width).
 		Margin(0, 2).
-		Render(m.review.Deck.Name)
+		Render(deck.Name)
+
+	notice := ""
+	if hints := len(m.review.Hints()); hints > 0 {
+		notice += m.styles.Text.Render(fmt.Sprintf(" • %d hint%s used", hints, pluralize(hints, "s")))
+	}
+	if card.Leech {
+		notice += m.styles.DeletedStatus.Render(
+			fmt.Sprintf(" • leech, forgotten %d time%s", card.Lapses, pluralize(int(card.Lapses), "s")),
+		)
+	}
 
 	position := m.styles.Text.
 		Width(m.width).
 		Margin(1, 2, 0).
-		Render(fmt.Sprintf("%d of %d", m.review.Current(), m.review.Total()))
+		Render(progress(m.review) + flagNotice(card) + notice)
 
-	card, err := m.review.Card()
+	markdown, err := renderContent(deck, card.Back(), m.cardImages, m.width-m.styles.Markdown.GetHorizontalFrameSize())
 	if err != nil {
 		return errorView(m.Shared, newErrorKeyMap(), err.Error())
 	}
-	markdown, err := RenderMarkdown(card.Answer, m.width-m.styles.Markdown.GetHorizontalFrameSize())
-	if err != nil {
-		return errorView(m.Shared, newErrorKeyMap(), err.Error())
+
+	if card.Notes != "" {
+		markdown = lipgloss.JoinVertical(lipgloss.Top, markdown, m.styles.Text.Render("Notes: "+card.Notes))
+	}
+
+	switch {
+	case m.comparison != nil:
+		markdown = lipgloss.JoinVertical(lipgloss.Top, m.comparisonView(), markdown)
+	case m.choice != "":
+		markdown = lipgloss.JoinVertical(lipgloss.Top, m.choiceView(), markdown)
 	}
 
 	footer := lipgloss.
@@ -363,35 +782,230 @@ func (m answerPage) View() string {
 	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, position, content, footer)
 }
 
+// comparisonView shows the typed answer, with the missing characters underlined
+// and the extra ones struck through, followed by the suggested score.
+func (m answerPage) comparisonView() string {
+	missing := m.styles.Text.Underline(true).Foreground(fuchsia)
+	extra := m.styles.DeletedStatus.Strikethrough(true)
+
+	var diff strings.Builder
+	for _, d := range m.comparison.Diffs {
+		switch d.Kind {
+		case flashcard.DiffMissing:
+			diff.WriteString(missing.Render(d.Text))
+		case flashcard.DiffExtra:
+			diff.WriteString(extra.Render(d.Text))
+		default:
+			diff.WriteString(m.styles.Text.Render(d.Text))
+		}
+	}
+
+	result := "Incorrect"
+	if m.comparison.Correct() {
+		result = "Correct"
+	}
+
+	return m.styles.Text.
+		Width(m.width).
+		Margin(0, 0, 1).
+		Render(
+			lipgloss.JoinVertical(
+				lipgloss.Top,
+				"Typed: "+diff.String(),
+				fmt.Sprintf("%s, %.0f%% similar • suggested %s", result, m.comparison.Similarity*100, scoreNames[m.suggested]),
+			),
+		)
+}
+
+// choiceView shows the chosen option, in red when it is not the answer, followed by the suggested score.
+func (m answerPage) choiceView() string {
+	choice, result := m.styles.Text.Render(m.choice), "Correct"
+	if m.suggested == flashcard.ReviewScoreAgain {
+		choice, result = m.styles.DeletedStatus.Render(m.choice), "Incorrect"
+	}
+
+	return m.styles.Text.
+		Width(m.width).
+		Margin(0, 0, 1).
+		Render(
+			lipgloss.JoinVertical(
+				lipgloss.Top,
+				"Chosen: "+choice,
+				fmt.Sprintf("%s • suggested %s", result, scoreNames[m.suggested]),
+			),
+		)
+}
+
+// Wait Page
+
+type waitKeyMap struct {
+	studyNow, quit key.Binding
+}
+
+func (k waitKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.studyNow, k.quit}
+}
+
+func (k waitKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.studyNow}, {k.quit}}
+}
+
+func newWaitPage(shared reviewShared) waitPage {
+	return waitPage{
+		reviewShared: shared,
+		spinner:      spinner.New(spinner.WithSpinner(spinner.Dot)),
+		keyMap: waitKeyMap{
+			studyNow: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "study now"),
+			),
+			quit: key.NewBinding(
+				key.WithKeys("q", "esc"),
+				key.WithHelp("q", "quit"),
+			),
+		},
+	}
+}
+
+// waitPage is shown when all the cards left wait for their next learning step.
+type waitPage struct {
+	reviewShared
+	spinner spinner.Model
+	keyMap  waitKeyMap
+}
+
+func (m waitPage) Init() tea.Cmd {
+	m.Log("wait: init")
+
+	return m.spinner.Tick
+}
+
+func (m waitPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("wait update: msg=%T", msg)
+
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.review.GoalReached() {
+			review := m.review
+			return m, func() tea.Msg { return endReview(review, m.repository) }
+		}
+		if m.review.Wait() == 0 {
+			return m, showQuestion(m.review)
+		}
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.studyNow):
+			return m, showQuestion(m.review)
+
+		case key.Matches(msg, m.keyMap.quit):
+			return m, quitReview(m.review, m.repository)
+		}
+	}
+
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m waitPage) View() string {
+	m.Log("wait view: width=%d height=%d", m.width, m.height)
+
+	header := m.styles.Title.
+		Margin(1, 2).
+		Render("Review")
+
+	position := m.styles.Text.
+		Width(m.width).
+		Margin(0, 2, 1).
+		Render(progress(m.review))
+
+	footer := lipgloss.
+		NewStyle().
+		Width(m.width).
+		Margin(1, 2).
+		Render(renderHelp(m.keyMap, m.width, false))
+
+	content := m.styles.Text.
+		Width(m.width).
+		Margin(0, 2).
+		Height(m.height - lipgloss.Height(header) - lipgloss.Height(position) - lipgloss.Height(footer)).
+		Render(fmt.Sprintf("%s Next card in %s", m.spinner.View(), formatWait(m.review.Wait())))
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, position, content, footer)
+}
+
+// formatWait renders the wait in seconds under a minute and in whole minutes after that.
+func formatWait(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Round(time.Second).Seconds()))
+	}
+	return fmt.Sprintf("%dm", int((d+time.Minute-1)/time.Minute))
+}
+
 // Review Summary Page
 
 type reviewSummaryKeyMap struct {
-	quit key.Binding
+	up, down, restudy, stats, quit key.Binding
 }
 
 func (k reviewSummaryKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.quit}
+	return []key.Binding{k.up, k.down, k.restudy, k.stats, k.quit}
 }
 
 func (k reviewSummaryKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.quit}}
+	return [][]key.Binding{{k.up, k.down}, {k.restudy, k.stats}, {k.quit}}
 }
 
 func newReviewSummaryPage(shared reviewShared) reviewSummaryPage {
-	return reviewSummaryPage{
+	lapsed := shared.review.Lapsed()
+
+	m := reviewSummaryPage{
 		reviewShared: shared,
+		lapsed:       lapsed,
 		keyMap: reviewSummaryKeyMap{
+			up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+				key.WithDisabled(),
+			),
+			down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+				key.WithDisabled(),
+			),
+			restudy: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "restudy lapsed"),
+				key.WithDisabled(),
+			),
+			stats: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "stats"),
+				key.WithDisabled(),
+			),
 			quit: key.NewBinding(
 				key.WithKeys("q", "esc"),
 				key.WithHelp("q", "quit"),
 			),
 		},
 	}
+	m.keyMap.restudy.SetEnabled(len(lapsed) > 0)
+	m.keyMap.stats.SetEnabled(len(lapsed) > 0)
+	return m.selectLapsed(0)
 }
 
 type reviewSummaryPage struct {
 	reviewShared
 	keyMap reviewSummaryKeyMap
+	lapsed []flashcard.Card
+	// selected is the index of the lapsed card selected.
+	selected int
 }
 
 func (m reviewSummaryPage) Init() tea.Cmd {
@@ -409,6 +1023,18 @@ func (m reviewSummaryPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		switch {
+		case key.Matches(msg, m.keyMap.up):
+			return m.selectLapsed(m.selected - 1), nil
+
+		case key.Matches(msg, m.keyMap.down):
+			return m.selectLapsed(m.selected + 1), nil
+
+		case key.Matches(msg, m.keyMap.restudy):
+			return m, restudyLapsed(m.review.Decks(), m.lapsed)
+
+		case key.Matches(msg, m.keyMap.stats):
+			return m, showLapsedStats(m.review.Decks(), m.lapsed[m.selected])
+
 		case key.Matches(msg, m.keyMap.quit):
 			return m, showDecks(0)
 		}
@@ -417,6 +1043,39 @@ func (m reviewSummaryPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// selectLapsed selects the lapsed card at index and enables the keys to move from it.
+func (m reviewSummaryPage) selectLapsed(index int) reviewSummaryPage {
+	m.selected = index
+	m.keyMap.up.SetEnabled(index > 0)
+	m.keyMap.down.SetEnabled(index < len(m.lapsed)-1)
+	return m
+}
+
+// restudyLapsed studies the lapsed cards again in cram mode, so their schedule is kept.
+func restudyLapsed(decks []flashcard.Deck, lapsed []flashcard.Card) tea.Cmd {
+	return func() tea.Msg {
+		return setReviewPageMsg{
+			decks: decks,
+			opts: []flashcard.ReviewOption{
+				flashcard.WithFilter(flashcard.Filter{Name: "Lapsed", Cram: true}),
+				flashcard.WithCards(lapsed),
+			},
+			restart: true,
+		}
+	}
+}
+
+// showLapsedStats shows the stats of the lapsed card, leaving them to the cards of its deck.
+func showLapsedStats(decks []flashcard.Deck, card flashcard.Card) tea.Cmd {
+	for _, deck := range decks {
+		index := slices.IndexFunc(deck.List(), func(c flashcard.Card) bool { return c.ID == card.ID })
+		if index >= 0 {
+			return showStats(index, deck.List()[index], deck)
+		}
+	}
+	return nil
+}
+
 func (m reviewSummaryPage) View() string {
 	m.Log("review-summary: view")
 
@@ -424,24 +1083,112 @@ func (m reviewSummaryPage) View() string {
 		Margin(1, 2).
 		Render("Congratulations!")
 
+	completed := m.review.Completed
+	subTitle := m.styles.SubTitle.
+		Width(m.width).
+		Margin(0, 2).
+		Render(fmt.Sprintf("%d card%s reviewed since %s.", completed, pluralize(completed, "s"), m.review.StartedAt.Format(time.Kitchen)))
+
 	footer := lipgloss.
 		NewStyle().
 		Width(m.width).
 		Margin(1, 2).
 		Render(renderHelp(m.keyMap, m.width, false))
 
-	completed := m.review.Completed
-	subTitle := m.styles.SubTitle.
+	lines := []string{
+		fmt.Sprintf(
+			"Again %d • Hard %d • Good %d • Easy %d",
+			m.review.Answers(flashcard.ReviewScoreAgain), m.review.Answers(flashcard.ReviewScoreHard),
+			m.review.Answers(flashcard.ReviewScoreGood), m.review.Answers(flashcard.ReviewScoreEasy),
+		),
+		fmt.Sprintf("Retention: %.0f%%.", m.review.Retention()*100),
+		timeSpent(m.review),
+		fmt.Sprintf("New cards learned: %d.", m.review.Learned()),
+		m.upcoming(),
+	}
+
+	if len(m.lapsed) > 0 {
+		lines = append(lines, "", fmt.Sprintf("Lapsed card%s:", pluralize(len(m.lapsed), "s")))
+		for i, card := range m.lapsed {
+			if i == m.selected {
+				lines = append(lines, m.styles.SelectedTitle.Render(card.Front()))
+			} else {
+				lines = append(lines, m.styles.Text.PaddingLeft(2).Render(card.Front()))
+			}
+		}
+	}
+
+	content := m.styles.Text.
 		Width(m.width).
-		Height(m.height-lipgloss.Height(header)-lipgloss.Height(footer)).
-		Margin(0, 2).
-		Render(fmt.Sprintf("%d card%s reviewed.", completed, pluralize(completed, "s")))
+		Height(m.height-lipgloss.Height(header)-lipgloss.Height(subTitle)-lipgloss.Height(footer)).
+		Margin(1, 2, 0).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, content, footer)
+}
 
-	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, footer)
+// upcoming renders when the next card of the decks studied is due and how many are due tomorrow.
+func (m reviewSummaryPage) upcoming() string {
+	var next time.Time
+	var tomorrow int
+	for _, deck := range m.review.Decks() {
+		if due := deck.NextDue(); !due.IsZero() && (next.IsZero() || due.Before(next)) {
+			next = deck.Settings.In(due)
+		}
+		tomorrow += deck.Forecast(2)[1]
+	}
+
+	if next.IsZero() {
+		return "No cards scheduled."
+	}
+	return fmt.Sprintf("Next due on %s, %d card%s due tomorrow.", next.Format("02/01/2006"), tomorrow, pluralize(tomorrow, "s"))
+}
+
+// timeSpent renders the total time spent answering and the average time per answer.
+func timeSpent(review flashcard.Review) string {
+	var answers int
+	for _, score := range []flashcard.ReviewScore{
+		flashcard.ReviewScoreAgain, flashcard.ReviewScoreHard, flashcard.ReviewScoreGood, flashcard.ReviewScoreEasy,
+	} {
+		answers += review.Answers(score)
+	}
+
+	if answers == 0 {
+		return fmt.Sprintf("Time spent: %s.", formatDuration(review.Elapsed()))
+	}
+	return fmt.Sprintf(
+		"Time spent: %s, %s per answer on average.",
+		formatDuration(review.Elapsed()), formatDuration(review.Elapsed()/time.Duration(answers)),
+	)
 }
 
 // Review SubPage
 
+// progress renders the position of the current card in the session, and the progress bar of the goal.
+func progress(review flashcard.Review) string {
+	position := fmt.Sprintf("%d of %d", review.Current(), review.Total())
+	if review.Cram() {
+		position += " • cram"
+	}
+	if review.Goal().IsZero() {
+		return position
+	}
+	return position + "\n" + goalBar(review.GoalProgress())
+}
+
+const goalBarWidth = 20
+
+// goalBar renders the progress towards the goal as a bar followed by the percentage.
+func goalBar(progress float64) string {
+	filled := int(progress * goalBarWidth)
+	return fmt.Sprintf(
+		"%s%s %d%% of the goal",
+		lipgloss.NewStyle().Foreground(fuchsia).Render(strings.Repeat("█", filled)),
+		strings.Repeat("░", goalBarWidth-filled),
+		int(progress*100),
+	)
+}
+
 func newReviewPage(shared Shared, review flashcard.Review) reviewPage {
 	rs := reviewShared{
 		Shared: shared,
@@ -491,7 +1238,7 @@ func (m reviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case showAnswerMsg:
 		m.review = msg.Review
-		m.page = newAnswerPage(m.reviewShared)
+		m.page = newAnswerPage(m.reviewShared, msg.response)
 		return m, m.page.Init()
 
 	case showQuestionMsg:
@@ -499,6 +1246,11 @@ func (m reviewPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.page = newQuestionPage(m.reviewShared)
 		return m, m.page.Init()
 
+	case showWaitMsg:
+		m.review = msg.Review
+		m.page = newWaitPage(m.reviewShared)
+		return m, m.page.Init()
+
 	case showReviewSummaryMsg:
 		m.review = msg.Review
 		m.page = newReviewSummaryPage(m.reviewShared)
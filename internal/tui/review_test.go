@@ -7,6 +7,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 
+	clock "github.com/eliostvs/lembrol/internal/clock/test"
 	"github.com/eliostvs/lembrol/internal/flashcard"
 	"github.com/eliostvs/lembrol/internal/tui"
 )
@@ -25,7 +26,7 @@ func TestQuestion(t *testing.T) {
 			assert.Contains(t, view, "Question A")
 			assert.Contains(t, view, "Golang One")
 			assert.Contains(t, view, "1 of 1")
-			assert.Contains(t, view, "enter answer • q quit")
+			assert.Contains(t, view, "enter answer • b bury • u suspend • f flag • q quit")
 			assert.NotContains(t, view, "s skip")
 		},
 	)
@@ -38,7 +39,7 @@ func TestQuestion(t *testing.T) {
 				Get().
 				View()
 
-			assert.Contains(t, view, "enter answer • q quit")
+			assert.Contains(t, view, "enter answer • b bury • u suspend • f flag • q quit")
 			assert.NotContains(t, view, "s skip")
 		},
 	)
@@ -51,7 +52,7 @@ func TestQuestion(t *testing.T) {
 				Get().
 				View()
 
-			assert.Contains(t, view, "s skip • enter answer • q quit")
+			assert.Contains(t, view, "s skip • enter answer • b bury • u suspend • f flag • q quit")
 		},
 	)
 
@@ -131,7 +132,7 @@ func TestQuestion(t *testing.T) {
 				SendKeyType(tea.KeyDown).
 				SendKeyRune(studyKey).
 				SendKeyType(tea.KeyEnter).
-				SendKeyRune(flashcard.ReviewScoreGood.String()).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
 				Get().
 				View()
 
@@ -164,6 +165,51 @@ func TestQuestion(t *testing.T) {
 	)
 }
 
+func TestQuestionBuryAndSuspend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{
+			name: "buries the card",
+			key:  buryKey,
+		},
+		{
+			name: "suspends the card",
+			key:  suspendKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				newTestModel(t, fewDecks).
+					Init().
+					SendKeyType(tea.KeyDown).
+					SendKeyRune(studyKey).
+					Peek(
+						func(m tea.Model) {
+							assert.Contains(t, m.View(), "1 of 2")
+						},
+					).
+					SendKeyRune(tt.key).
+					Peek(
+						func(m tea.Model) {
+							assert.Contains(t, m.View(), "1 of 1")
+						},
+					).
+					SendKeyRune(tt.key).
+					Peek(
+						func(m tea.Model) {
+							assert.Contains(t, m.View(), "0 card reviewed since")
+						},
+					)
+			},
+		)
+	}
+}
+
 func TestAnswer(t *testing.T) {
 	t.Parallel()
 
@@ -194,9 +240,9 @@ func TestAnswer(t *testing.T) {
 				Get().
 				View()
 
-			assert.Contains(t, view, "1 again    2 hard    q quit")
-			assert.Contains(t, view, "3 good    ? close help")
-			assert.Contains(t, view, "4 easy")
+			assert.Contains(t, view, "1 again    2 hard    f flag")
+			assert.Contains(t, view, "3 good    q quit")
+			assert.Contains(t, view, "4 easy    ? close help")
 		},
 	)
 
@@ -210,26 +256,25 @@ func TestAnswer(t *testing.T) {
 				Get().
 				View()
 
-			assert.NotContains(t, view, "1 again")
-			assert.Contains(t, view, "2 hard    q quit")
-			assert.Contains(t, view, "3 good    ? close help")
-			assert.Contains(t, view, "4 easy")
+			assert.Contains(t, view, "1 again    2 hard    f flag")
+			assert.Contains(t, view, "3 good    q quit")
+			assert.Contains(t, view, "4 easy    ? close help")
 		},
 	)
 
-	t.Run("do not show again option in the last card", func(t *testing.T) {
+	t.Run("shows again option in the last card", func(t *testing.T) {
 		view := newTestModel(t, fewDecks).
 			Init().
 			SendKeyRune(keyDown).
 			SendKeyRune(studyKey).
 			SendKeyType(tea.KeyEnter).
-			SendKeyRune("3").
+			SendKeyRune("4").
 			SendKeyType(tea.KeyEnter).
 			Get().
 			View()
 
-		assert.NotContains(t, view, "1 again")
-		assert.Contains(t, view, "2 hard • 3 good • 4 easy • q quit • ? more")
+		assert.Contains(t, view, "2 of 2")
+		assert.Contains(t, view, "1 again • 2 hard • 3 good • 4 easy • q quit • ? more")
 	})
 
 	t.Run(
@@ -261,12 +306,12 @@ func TestAnswer(t *testing.T) {
 				{
 					name: "score hard",
 					args: flashcard.ReviewScoreHard,
-					want: "2 of 6",
+					want: "1 of 6",
 				},
 				{
 					name: "score good",
 					args: flashcard.ReviewScoreGood,
-					want: "2 of 6",
+					want: "1 of 6",
 				},
 				{
 					name: "score easy",
@@ -356,7 +401,7 @@ func TestAnswer(t *testing.T) {
 				Init().
 				SendKeyRune(studyKey).
 				SendKeyType(tea.KeyEnter).
-				SendKeyRune(flashcard.ReviewScoreGood.String()).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
 				Get().
 				View()
 
@@ -397,23 +442,143 @@ func TestReview(t *testing.T) {
 				Init().
 				SendKeyRune(studyKey).
 				SendKeyType(tea.KeyEnter).
-				SendKeyRune(flashcard.ReviewScoreGood.String()).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
 				Get().
 				View()
 
 			assert.Contains(t, view, "Congratulations!")
 			assert.Contains(t, view, "1 card reviewed")
+			assert.Contains(t, view, "Time spent: 0s, 0s per answer on average.")
 			assert.Contains(t, view, "q quit")
 		},
 	)
 
+	t.Run(
+		"shows the summary of the review", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreAgain.String()).
+				SendKeyType(tea.KeyEnter).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Again 1 • Hard 0 • Good 0 • Easy 1")
+			assert.Contains(t, view, "Retention: 50%.")
+			assert.Contains(t, view, "New cards learned: 0.")
+			assert.Contains(t, view, "Next due on 12/01/2021, 0 card due tomorrow.")
+			assert.Contains(t, view, "Lapsed card:")
+			assert.Contains(t, view, activePrompt+"Question A")
+			assert.Contains(t, view, "r restudy lapsed • enter stats • q quit")
+		},
+	)
+
+	t.Run(
+		"shows the stats of the lapsed card", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreAgain.String()).
+				SendKeyType(tea.KeyEnter).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Stats")
+			assert.Contains(t, view, "TOTAL")
+			assert.Contains(t, view, "Question A")
+		},
+	)
+
+	t.Run(
+		"restudies the lapsed cards", func(t *testing.T) {
+			view := newTestModel(t, leechDeck, tui.WithClock(clock.New(leechTime))).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreAgain.String()).
+				SendKeyType(tea.KeyEnter).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				SendKeyRune("r").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Question A")
+			assert.Contains(t, view, "1 of 1 • cram")
+		},
+	)
+
+	t.Run(
+		"does not offer the lapsed keys without lapses", func(t *testing.T) {
+			view := newTestModel(t, singleCardDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "Lapsed")
+			assert.NotContains(t, view, "restudy")
+		},
+	)
+
+	t.Run(
+		"shows the progress of the goal", func(t *testing.T) {
+			view := newTestModel(t, fewDecks, tui.WithGoal(flashcard.Goal{Cards: 2})).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				Get().
+				View()
+
+			assert.Contains(t, view, "2 of 6")
+			assert.Contains(t, view, "██████████░░░░░░░░░░ 50% of the goal")
+		},
+	)
+
+	t.Run(
+		"wraps up the review when the goal is reached", func(t *testing.T) {
+			view := newTestModel(t, fewDecks, tui.WithGoal(flashcard.Goal{Cards: 1})).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Congratulations!")
+			assert.Contains(t, view, "1 card reviewed")
+		},
+	)
+
+	t.Run(
+		"does not show the goal without one", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune(studyKey).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "of the goal")
+		},
+	)
+
 	t.Run(
 		"goes to home page when review ends", func(t *testing.T) {
 			view := newTestModel(t, singleCardDeck).
 				Init().
 				SendKeyRune(studyKey).
 				SendKeyType(tea.KeyEnter).
-				SendKeyRune(flashcard.ReviewScoreGood.String()).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
 				SendKeyType(tea.KeyEsc).
 				Get().
 				View()
@@ -422,6 +587,55 @@ func TestReview(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"waits for the next learning step", func(t *testing.T) {
+			view := newTestModel(t, singleCardDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreGood.String()).
+				Get().
+				View()
+
+			assert.Contains(t, view, "1 of 1")
+			assert.Contains(t, view, "Next card in 10m")
+			assert.Contains(t, view, "enter study now • q quit")
+		},
+	)
+
+	t.Run(
+		"studies the waiting card now", func(t *testing.T) {
+			view := newTestModel(t, singleCardDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreAgain.String()).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Question")
+			assert.Contains(t, view, latestCard.Question)
+			assert.Contains(t, view, "1 of 1")
+		},
+	)
+
+	t.Run(
+		"leaves the waiting session", func(t *testing.T) {
+			view := newTestModel(t, singleCardDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(flashcard.ReviewScoreAgain.String()).
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang One")
+			assert.Contains(t, view, "1 item")
+		},
+	)
+
 	t.Run(
 		"changes the height when the window resize", func(t *testing.T) {
 			var before string
@@ -444,3 +658,427 @@ func TestReview(t *testing.T) {
 		},
 	)
 }
+
+func TestReviewResume(t *testing.T) {
+	t.Parallel()
+
+	// interrupt answers the first card of the deck and quits the review.
+	interrupt := func(t *testing.T) *testModel {
+		return newTestModel(t, fewDecks).
+			Init().
+			SendKeyRune(studyKey).
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(flashcard.ReviewScoreEasy.String()).
+			SendKeyRune(quitKey)
+	}
+
+	t.Run(
+		"offers to resume the interrupted review", func(t *testing.T) {
+			view := interrupt(t).
+				SendKeyRune(studyKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang A")
+			assert.Contains(t, view, "Resume the review started at")
+			assert.Contains(t, view, "1 card reviewed, 5 cards left.")
+			assert.Contains(t, view, "enter resume • n start over • q quit")
+		},
+	)
+
+	t.Run(
+		"resumes the review", func(t *testing.T) {
+			view := interrupt(t).
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Question")
+			assert.Contains(t, view, "2 of 6")
+		},
+	)
+
+	t.Run(
+		"starts a new review", func(t *testing.T) {
+			view := interrupt(t).
+				SendKeyRune(studyKey).
+				SendKeyRune("n").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Question")
+			assert.Contains(t, view, "1 of 5")
+		},
+	)
+
+	t.Run(
+		"goes back to the cards", func(t *testing.T) {
+			view := interrupt(t).
+				SendKeyRune(studyKey).
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Golang A")
+			assert.Contains(t, view, "6 items")
+		},
+	)
+
+	t.Run(
+		"does not offer to resume in a scoped review", func(t *testing.T) {
+			view := newTestModel(t, tagsDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyRune(flashcard.ReviewScoreEasy.String()).
+				SendKeyRune(quitKey).
+				SendKeyRune(filterKey).
+				SendKeyRune("tag:linux").
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune(studyKey).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "Resume the review")
+			assert.Contains(t, view, "Question")
+		},
+	)
+
+	t.Run(
+		"discards the session of the decks studied again", func(t *testing.T) {
+			view := newTestModel(t, fewDecks).
+				Init().
+				SendKeyRune("S").
+				SendKeyRune(quitKey).
+				SendKeyRune(keyDown).
+				SendKeyType(tea.KeySpace).
+				SendKeyRune("S").
+				SendKeyRune(quitKey).
+				SendKeyRune(quitKey).
+				SendKeyRune("S").
+				Get().
+				View()
+
+			assert.NotContains(t, view, "Resume the review")
+			assert.Contains(t, view, "1 of 8")
+		},
+	)
+}
+
+func TestReviewCloze(t *testing.T) {
+	t.Parallel()
+
+	m := newTestModel(t, emptyDeck).
+		Init().
+		SendKeyType(tea.KeyEnter).
+		SendKeyRune(createKey).
+		SendKeyRune("{{c1::Go}} is {{c2::fast::speed}}").
+		SendKeyType(tea.KeyTab).
+		SendKeyRune("Extra").
+		SendKeyRune(saveKey).
+		SendKeyRune(quitKey).
+		SendKeyRune(studyKey)
+
+	question := m.Get().View()
+	assert.Contains(t, question, "1 of 2")
+	assert.True(t, strings.Contains(question, "[...] is fast") || strings.Contains(question, "Go is [speed]"))
+
+	answer := m.SendKeyType(tea.KeyEnter).Get().View()
+	assert.Contains(t, answer, "Go is fast")
+	assert.Contains(t, answer, "Extra")
+	assert.NotContains(t, answer, "[...]")
+	assert.NotContains(t, answer, "[speed]")
+}
+
+func TestReviewTypeAnswer(t *testing.T) {
+	t.Parallel()
+
+	newTypeAnswerModel := func(t *testing.T) *testModel {
+		return newTestModel(t, emptyDeck).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(createKey).
+			SendKeyRune("Replay the commits").
+			SendKeyType(tea.KeyTab).
+			SendKeyRune("rebase").
+			SendKeyRune(typeKey).
+			SendKeyRune(saveKey).
+			SendKeyRune(quitKey).
+			SendKeyRune(studyKey)
+	}
+
+	t.Run(
+		"shows an input for the answer", func(t *testing.T) {
+			view := newTypeAnswerModel(t).
+				SendKeyRune("bus").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Replay the commits")
+			assert.Contains(t, view, "> bus")
+			assert.Contains(t, view, "enter answer • esc quit")
+			assert.NotContains(t, view, "bury")
+		},
+	)
+
+	t.Run(
+		"compares the typed answer and suggests a score", func(t *testing.T) {
+			view := newTypeAnswerModel(t).
+				SendKeyRune("rebsae").
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Typed: rebsase")
+			assert.Contains(t, view, "Incorrect, 83% similar • suggested hard")
+			assert.Contains(t, view, "enter hard • 1 again")
+		},
+	)
+
+	t.Run(
+		"scores the card with the suggestion", func(t *testing.T) {
+			view := newTypeAnswerModel(t).
+				SendKeyRune("rebase").
+				SendKeyType(tea.KeyEnter).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Correct, 100% similar • suggested good")
+					},
+				).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			// good moves the new card to its next learning step.
+			assert.Contains(t, view, "Next card in 10m")
+		},
+	)
+
+	t.Run(
+		"scores the card overriding the suggestion", func(t *testing.T) {
+			view := newTypeAnswerModel(t).
+				SendKeyRune("rebase").
+				SendKeyType(tea.KeyEnter).
+				SendKeyRune("4").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Again 0 • Hard 0 • Good 0 • Easy 1")
+		},
+	)
+}
+
+func TestReviewMultipleChoice(t *testing.T) {
+	t.Parallel()
+
+	newChoicesModel := func(t *testing.T) *testModel {
+		return newTestModel(t, emptyDeck).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(createKey).
+			SendKeyRune(choicesKey).
+			SendKeyRune("Capital of France").
+			SendKeyType(tea.KeyTab).
+			SendKeyRune("Paris").
+			SendKeyType(tea.KeyTab).
+			SendKeyRune("Lyon").
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune("Nice").
+			SendKeyRune(saveKey).
+			SendKeyRune(quitKey).
+			SendKeyRune(studyKey)
+	}
+
+	// choice returns the number of the option in the question.
+	choice := func(view, option string) string {
+		for _, line := range strings.Split(view, "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasSuffix(line, ". "+option) {
+				return strings.TrimSuffix(line, ". "+option)
+			}
+		}
+		return ""
+	}
+
+	t.Run(
+		"shows the numbered options", func(t *testing.T) {
+			view := newChoicesModel(t).Get().View()
+
+			assert.Contains(t, view, "Capital of France")
+			assert.NotEmpty(t, choice(view, "Paris"))
+			assert.NotEmpty(t, choice(view, "Lyon"))
+			assert.NotEmpty(t, choice(view, "Nice"))
+			assert.Contains(t, view, "1-3 choose")
+		},
+	)
+
+	t.Run(
+		"grades the right choice as good", func(t *testing.T) {
+			m := newChoicesModel(t)
+
+			view := m.SendKeyRune(choice(m.Get().View(), "Paris")).Get().View()
+
+			assert.Contains(t, view, "Chosen: Paris")
+			assert.Contains(t, view, "Correct • suggested good")
+			assert.Contains(t, view, "enter good")
+		},
+	)
+
+	t.Run(
+		"grades the wrong choice as again", func(t *testing.T) {
+			m := newChoicesModel(t)
+
+			view := m.SendKeyRune(choice(m.Get().View(), "Nice")).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Chosen: Nice")
+						assert.Contains(t, m.View(), "Incorrect • suggested again")
+					},
+				).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			// again keeps the new card at its first learning step.
+			assert.Contains(t, view, "Next card in 60s")
+		},
+	)
+}
+
+func TestReviewMedia(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"shows the path of the images", func(t *testing.T) {
+			view := newTestModel(t, mediaDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "TCP handshake")
+			assert.Contains(t, view, "handshake.png")
+			assert.NotContains(t, view, "\x1b_G")
+		},
+	)
+
+	t.Run(
+		"draws the images", func(t *testing.T) {
+			view := newTestModel(t, mediaDeck, tui.WithImages(tui.ImagesKitty)).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "TCP handshake")
+			assert.Contains(t, view, "\x1b_Ga=T,f=100")
+		},
+	)
+}
+
+func TestReviewHints(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"reveals the hints one at a time", func(t *testing.T) {
+			view := newTestModel(t, hintsDeck).
+				Init().
+				SendKeyRune(studyKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "h hint")
+						assert.NotContains(t, m.View(), "Hint 1")
+					},
+				).
+				SendKeyRune(hintKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "Hint 1: Transport layer")
+						assert.NotContains(t, m.View(), "Hint 2")
+					},
+				).
+				SendKeyRune(hintKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Hint 2: Three way handshake")
+			assert.NotContains(t, view, "h hint")
+		},
+	)
+
+	t.Run(
+		"suggests hard after using hints", func(t *testing.T) {
+			view := newTestModel(t, hintsDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyRune(hintKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "1 hint used")
+			assert.Contains(t, view, "enter hard")
+		},
+	)
+
+	t.Run(
+		"leaves the score to the user without hints", func(t *testing.T) {
+			view := newTestModel(t, hintsDeck).
+				Init().
+				SendKeyRune(studyKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.NotContains(t, view, "hint used")
+			assert.NotContains(t, view, "enter hard")
+		},
+	)
+}
+
+func TestReviewFlags(t *testing.T) {
+	t.Parallel()
+
+	t.Run(
+		"flags the card in the question and the answer", func(t *testing.T) {
+			view := newTestModel(t, flagsDeck).
+				Init().
+				SendKeyRune(studyKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ red")
+						assert.Contains(t, m.View(), "f flag")
+					},
+				).
+				SendKeyRune(flagKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ orange")
+					},
+				).
+				SendKeyType(tea.KeyEnter).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ orange")
+						assert.Contains(t, m.View(), "Notes: Verify the source")
+					},
+				).
+				SendKeyRune(flagKey).
+				Peek(
+					func(m tea.Model) {
+						assert.Contains(t, m.View(), "⚑ green")
+					},
+				).
+				SendKeyRune("4").
+				SendKeyRune(quitKey).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "⚑ green")
+			assert.NotContains(t, view, "⚑ red")
+		},
+	)
+}
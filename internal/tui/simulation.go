@@ -0,0 +1,201 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
+)
+
+// Bounds and step of the desired retention changed in the simulation page.
+const (
+	minSimulationRetention  = 0.70
+	maxSimulationRetention  = 0.99
+	simulationRetentionStep = 0.01
+)
+
+var simulationLevels = []rune("▁▂▃▄▅▆▇█")
+
+func percent(value float64, reviews int) string {
+	if reviews == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", value*100)
+}
+
+// simulationSummary renders the totals of the simulation in a sentence.
+func simulationSummary(simulation flashcard.Simulation) string {
+	reviews, days := simulation.Reviews(), len(simulation.Days)
+	return fmt.Sprintf(
+		"%d review%s in %d day%s, %.1f per day on average, %s expected retention.",
+		reviews, pluralize(reviews, "s"), days, pluralize(days, "s"),
+		float64(reviews)/float64(max(days, 1)), percent(simulation.Retention(), reviews),
+	)
+}
+
+type simulationKeyMap struct {
+	raise, lower, quit key.Binding
+}
+
+func (k simulationKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.raise, k.lower, k.quit}
+}
+
+func (k simulationKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+func newSimulationPage(shared Shared, msg setSimulationPageMsg) simulationPage {
+	m := simulationPage{
+		Shared:    shared,
+		card:      msg.card,
+		cardIndex: msg.cardIndex,
+		deck:      msg.deck,
+		opts:      msg.deck.DefaultSimulationOptions(),
+		keyMap: simulationKeyMap{
+			raise: key.NewBinding(
+				key.WithKeys("+", "="),
+				key.WithHelp("+", "raise retention"),
+			),
+			lower: key.NewBinding(
+				key.WithKeys("-"),
+				key.WithHelp("-", "lower retention"),
+			),
+			quit: key.NewBinding(
+				key.WithKeys("q", "esc"),
+				key.WithHelp("q", "quit"),
+			),
+		},
+	}
+	return m.simulate()
+}
+
+// simulationPage forecasts the reviews of the deck and how they change with the desired retention.
+type simulationPage struct {
+	Shared
+	card       flashcard.Card
+	cardIndex  int
+	deck       flashcard.Deck
+	opts       flashcard.SimulationOptions
+	simulation flashcard.Simulation
+	err        error
+	keyMap     simulationKeyMap
+}
+
+// simulate runs the simulation with the current options and enables the keys to change the retention.
+func (m simulationPage) simulate() simulationPage {
+	m.simulation, m.err = m.deck.Simulate(m.opts)
+	m.keyMap.raise.SetEnabled(m.opts.DesiredRetention < maxSimulationRetention-simulationRetentionStep/2)
+	m.keyMap.lower.SetEnabled(m.opts.DesiredRetention > minSimulationRetention+simulationRetentionStep/2)
+	return m
+}
+
+func (m simulationPage) Init() tea.Cmd {
+	m.Log("simulation: init")
+
+	return nil
+}
+
+func (m simulationPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.Log("simulation update: msg=%T", msg)
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.raise):
+			m.opts.DesiredRetention = math.Round((m.opts.DesiredRetention+simulationRetentionStep)*100) / 100
+			return m.simulate(), nil
+
+		case key.Matches(msg, m.keyMap.lower):
+			m.opts.DesiredRetention = math.Round((m.opts.DesiredRetention-simulationRetentionStep)*100) / 100
+			return m.simulate(), nil
+
+		case key.Matches(msg, m.keyMap.quit):
+			return m, showStats(m.cardIndex, m.card, m.deck)
+		}
+	}
+
+	return m, nil
+}
+
+func (m simulationPage) View() string {
+	m.Log("simulation view: width=%d height=%d", m.width, m.height)
+
+	if m.err != nil {
+		return errorView(m.Shared, newErrorKeyMap(), m.err.Error())
+	}
+
+	header := m.styles.Title.
+		Margin(1, 2).
+		Render("Forecast")
+
+	subTitle := m.styles.SubTitle.
+		Width(m.width).
+		Margin(0, 2).
+		Render(m.deck.Name)
+
+	footer := lipgloss.
+		NewStyle().
+		Width(m.width).
+		Margin(1, 2).
+		Render(renderHelp(m.keyMap, m.width, false))
+
+	days := m.simulation.Days
+	lines := []string{
+		fmt.Sprintf(
+			"Desired retention %.0f%% • %d new card%s per day",
+			m.opts.DesiredRetention*100, m.opts.NewPerDay, pluralize(m.opts.NewPerDay, "s"),
+		),
+		"",
+		m.styles.SubTitle.Render(reviewsChart(days)),
+		fmt.Sprintf(
+			"%s%s%s",
+			days[0].Date.Format("02/01/2006"),
+			strings.Repeat(" ", max(1, len(days)-20)),
+			days[len(days)-1].Date.Format("02/01/2006"),
+		),
+		"",
+		simulationSummary(m.simulation),
+	}
+
+	busiest := slices.MaxFunc(days, func(a, b flashcard.SimulatedDay) int { return a.Reviews - b.Reviews })
+	if busiest.Reviews > 0 {
+		lines = append(lines, fmt.Sprintf("Busiest day %s with %d reviews.", busiest.Date.Format("02/01/2006"), busiest.Reviews))
+	}
+
+	content := m.styles.Text.
+		Width(m.width).
+		Height(m.height-lipgloss.Height(header)-lipgloss.Height(subTitle)-lipgloss.Height(footer)).
+		Margin(1, 2, 0).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, subTitle, content, footer)
+}
+
+// reviewsChart renders the reviews of each day relative to the busiest one.
+func reviewsChart(days []flashcard.SimulatedDay) string {
+	var most int
+	for _, day := range days {
+		most = max(most, day.Reviews)
+	}
+
+	var chart strings.Builder
+	for _, day := range days {
+		level := 0
+		if most > 0 {
+			level = day.Reviews * (len(simulationLevels) - 1) / most
+		}
+		chart.WriteRune(simulationLevels[level])
+	}
+	return chart.String()
+}
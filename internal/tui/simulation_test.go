@@ -0,0 +1,60 @@
+package tui_test
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+const forecastKey = "w"
+
+func TestSimulation(t *testing.T) {
+	t.Parallel()
+
+	openForecast := func(t *testing.T) *testModel {
+		return newTestModel(t, fewDecks).
+			Init().
+			SendKeyType(tea.KeyEnter).
+			SendKeyType(tea.KeyEnter).
+			SendKeyRune(forecastKey)
+	}
+
+	t.Run(
+		"shows the forecast of the deck", func(t *testing.T) {
+			view := openForecast(t).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Forecast")
+			assert.Contains(t, view, "Desired retention 90% • 20 new cards per day")
+			assert.Contains(t, view, "in 30 days")
+			assert.Contains(t, view, "+ raise retention • - lower retention • q quit")
+		},
+	)
+
+	t.Run(
+		"changes the desired retention", func(t *testing.T) {
+			view := openForecast(t).
+				SendKeyRune("+").
+				SendKeyRune("+").
+				SendKeyRune("-").
+				Get().
+				View()
+
+			assert.Contains(t, view, "Desired retention 91%")
+		},
+	)
+
+	t.Run(
+		"goes back to the stats", func(t *testing.T) {
+			view := openForecast(t).
+				SendKeyRune(quitKey).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Stats")
+			assert.Contains(t, view, "TOTAL")
+		},
+	)
+}
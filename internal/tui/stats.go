@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,17 +20,19 @@ import (
 var levels = []rune("▁▃▅█")
 
 type statsKeyMap struct {
-	cancel key.Binding
+	forecast key.Binding
+	cancel   key.Binding
 }
 
 func (k statsKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
+		k.forecast,
 		k.cancel,
 	}
 }
 
 func (k statsKeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{{k.cancel}}
+	return [][]key.Binding{{k.forecast, k.cancel}}
 }
 
 type statsState int
@@ -48,7 +51,11 @@ func newStatsModel(shared Shared, msg setStatsPageMsg) statsModel {
 		loading:   newLoadingPage(shared, "Stats", "Loading..."),
 		state:     statsLoading,
 		keyMap: statsKeyMap{
-			key.NewBinding(
+			forecast: key.NewBinding(
+				key.WithKeys("w"),
+				key.WithHelp("w", "forecast"),
+			),
+			cancel: key.NewBinding(
 				key.WithKeys("q", tea.KeyEsc.String()),
 				key.WithHelp("q", "quit"),
 			),
@@ -69,6 +76,11 @@ type sparklineItem struct {
 	level     string
 }
 
+// timeStats holds the answer times of the timed reviews of a card.
+type timeStats struct {
+	Total, Average, Fastest, Slowest, Last time.Duration
+}
+
 type statsModel struct {
 	Shared
 	card      flashcard.Card
@@ -78,7 +90,10 @@ type statsModel struct {
 	loading   tea.Model
 	state     statsState
 	totals    map[flashcard.ReviewScore]int
+	times     timeStats
 	sparkline []sparklineItem
+	// hinted is the number of reviews answered with hints.
+	hinted int
 }
 
 // MESSAGES
@@ -125,10 +140,16 @@ func (m statsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.state = statsLoaded
 		m.sparkline = createSparkline(msg.stats)
 		m.totals = calculateTotals(msg.stats)
+		m.times = calculateTimes(msg.stats)
+		m.hinted = flashcard.HintedReviews(msg.stats)
 		return m, cmd
 
 	case tea.KeyMsg:
-		if key.Matches(msg, m.keyMap.cancel) {
+		switch {
+		case key.Matches(msg, m.keyMap.forecast) && m.state == statsLoaded:
+			return m, showSimulation(m.cardIndex, m.card, m.deck)
+
+		case key.Matches(msg, m.keyMap.cancel):
 			return m, showCards(m.cardIndex, m.deck)
 		}
 	}
@@ -145,6 +166,39 @@ func calculateTotals(stats []flashcard.Stats) map[flashcard.ReviewScore]int {
 	return totals
 }
 
+// calculateTimes returns the answer times of the timed reviews, or the zero value when no review was timed.
+func calculateTimes(stats []flashcard.Stats) timeStats {
+	var total, fastest, slowest, last time.Duration
+	var count int
+	var lastReview time.Time
+	for _, stat := range stats {
+		if stat.Duration <= 0 {
+			continue
+		}
+
+		count++
+		total += stat.Duration
+		if fastest == 0 || stat.Duration < fastest {
+			fastest = stat.Duration
+		}
+		slowest = max(slowest, stat.Duration)
+		if !stat.LastReview.Before(lastReview) {
+			last, lastReview = stat.Duration, stat.LastReview
+		}
+	}
+
+	if count == 0 {
+		return timeStats{}
+	}
+	return timeStats{
+		Total:   total,
+		Average: total / time.Duration(count),
+		Fastest: fastest,
+		Slowest: slowest,
+		Last:    last,
+	}
+}
+
 func createSparkline(stats []flashcard.Stats) []sparklineItem {
 	sparkline := make([]sparklineItem, 0, len(stats))
 
@@ -192,7 +246,7 @@ func notStatsView(m statsModel) string {
 
 	subTitle := m.styles.SubTitle.
 		Margin(0, 2, 1).
-		Render(m.card.Question)
+		Render(m.card.Front())
 
 	v := help.New()
 	v.ShowAll = false
@@ -222,19 +276,19 @@ func cardStatsView(m statsModel) string {
 
 	question := m.styles.SubTitle.
 		Margin(0, 2, 0).
-		Render(m.card.Question)
+		Render(m.card.Front())
 
 	margins := 4
 	firstSession := m.styles.Text.
 		Width(width*(sections-1)+margins*(sections-2)).
 		Margin(1, 2).
 		Align(lipgloss.Left).
-		Render(m.sparkline[0].timestamp.Format("02/01/2006"))
+		Render(m.deck.Settings.In(m.sparkline[0].timestamp).Format("02/01/2006"))
 	lastSession := m.styles.Text.
 		Width(width).
 		Margin(1, 2).
 		Align(lipgloss.Left).
-		Render(m.sparkline[len(m.sparkline)-1].timestamp.Format("02/01/2006"))
+		Render(m.deck.Settings.In(m.sparkline[len(m.sparkline)-1].timestamp).Format("02/01/2006"))
 	dates := lipgloss.JoinHorizontal(lipgloss.Left, firstSession, lastSession)
 
 	headerStyle := lipgloss.NewStyle().
@@ -258,6 +312,30 @@ func cardStatsView(m statsModel) string {
 	}
 	totals := lipgloss.JoinHorizontal(lipgloss.Left, scoreTotals...)
 
+	timeLabels := make([]string, sections)
+	for i, label := range []string{"TIME", "AVERAGE", "FASTEST", "SLOWEST", "LAST"} {
+		timeLabels[i] = headerStyle.MarginTop(1).Render(label)
+	}
+	timeHeader := lipgloss.JoinHorizontal(lipgloss.Left, timeLabels...)
+
+	timeValues := make([]string, 0, sections)
+	for _, duration := range []time.Duration{m.times.Total, m.times.Average, m.times.Fastest, m.times.Slowest, m.times.Last} {
+		value := "-"
+		if m.times.Total > 0 {
+			value = formatDuration(duration)
+		}
+		timeValues = append(timeValues, totalStyle.Render(value))
+	}
+	times := lipgloss.JoinHorizontal(lipgloss.Left, timeValues...)
+
+	hinted, hintedHeight := "", 0
+	if m.hinted > 0 {
+		hinted = m.styles.Text.
+			Margin(1, 2, 0).
+			Render(fmt.Sprintf("Hints used in %d of %d reviews", m.hinted, m.totals[flashcard.ReviewScore(0)]))
+		hintedHeight = lipgloss.Height(hinted)
+	}
+
 	actions := lipgloss.
 		NewStyle().
 		Width(m.width).
@@ -272,8 +350,12 @@ func cardStatsView(m statsModel) string {
 		Width(m.width).
 		Margin(1, 2).
 		Align(lipgloss.Left).
-		Height(m.height - lipgloss.Height(title) - lipgloss.Height(question) - lipgloss.Height(dates) - lipgloss.Height(totalLabels) - lipgloss.Height(dates) - lipgloss.Height(totals) - lipgloss.Height(actions)).
+		Height(m.height - lipgloss.Height(title) - lipgloss.Height(question) - lipgloss.Height(dates) - lipgloss.Height(totalLabels) - lipgloss.Height(dates) - lipgloss.Height(totals) - lipgloss.Height(timeHeader) - lipgloss.Height(times) - hintedHeight - lipgloss.Height(actions)).
 		Render(content.String())
 
-	return lipgloss.JoinVertical(lipgloss.Top, title, question, dates, totalLabels, totals, sparkline, actions)
+	views := []string{title, question, dates, totalLabels, totals, timeHeader, times}
+	if hinted != "" {
+		views = append(views, hinted)
+	}
+	return lipgloss.JoinVertical(lipgloss.Top, append(views, sparkline, actions)...)
 }
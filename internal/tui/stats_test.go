@@ -39,11 +39,26 @@ func TestCardStats(t *testing.T) {
 			assert.Contains(t, view, "27/02/2022")
 			assert.Contains(t, view, "TOTAL           AGAIN           HARD            GOOD            EASY")
 			assert.Contains(t, view, "21              5               6               5               5")
+			assert.Contains(t, view, "TIME            AVERAGE         FASTEST         SLOWEST         LAST")
+			assert.Contains(t, view, "-               -               -               -               -")
 			assert.Contains(t, view, "▃▅▃▅▁█▅▅▁▃▁▃▁▅█▁█▃▃██")
 			assert.Contains(t, view, "q quit")
 		},
 	)
 
+	t.Run(
+		"shows the reviews answered with hints", func(t *testing.T) {
+			view := newTestModel(t, hintsDeck).
+				Init().
+				SendKeyType(tea.KeyEnter).
+				SendKeyType(tea.KeyEnter).
+				Get().
+				View()
+
+			assert.Contains(t, view, "Hints used in 1 of 1 reviews")
+		},
+	)
+
 	t.Run(
 		"goes back to card page", func(t *testing.T) {
 			tests := []struct {
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/charmbracelet/glamour/styles"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dustin/go-humanize"
+
+	"github.com/eliostvs/lembrol/internal/flashcard"
 )
 
 func init() {
@@ -35,6 +38,20 @@ var (
 	fieldStyle  = lipgloss.NewStyle().Foreground(white).Padding(1, 0, 0)
 )
 
+// flagColors are the colours the flags of the cards are shown with.
+var flagColors = map[flashcard.Flag]lipgloss.Color{
+	flashcard.FlagRed:    "#ED567A",
+	flashcard.FlagOrange: "#F5A05A",
+	flashcard.FlagGreen:  "#5FD787",
+	flashcard.FlagBlue:   "#5F87FF",
+	flashcard.FlagPurple: "#AF87FF",
+}
+
+// flagView shows the flag in its colour, like ⚑ red.
+func flagView(flag flashcard.Flag) string {
+	return lipgloss.NewStyle().Foreground(flagColors[flag]).Render("⚑ " + flag.String())
+}
+
 type Styles struct {
 	List,
 	Markdown,
@@ -95,7 +112,7 @@ func RenderMarkdown(text string, width int) (string, error) {
 		glamour.WithWordWrap(width),
 	)
 
-	lines, err := r.Render(breakLines(text))
+	lines, err := r.Render(RenderMath(breakLines(text)))
 	if err != nil {
 		return "", err
 	}
@@ -114,6 +131,19 @@ func pluralize(val int, suffix string) string {
 	return ""
 }
 
+// formatDuration renders a duration in seconds, minutes and hours, leaving out the larger units when zero.
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm %02ds", int(d.Minutes()), int(d.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
 func renderHelp(keyMap help.KeyMap, width int, fullHelp bool) string {
 	model := help.New()
 	model.Width = width